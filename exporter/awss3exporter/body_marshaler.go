@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
+
+import (
+	"bytes"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// bodyMarshaler writes the bodies of the log records as they are, one per line,
+// leaving out their attributes and resources.
+type bodyMarshaler struct{}
+
+func (marshaler *bodyMarshaler) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	var buf bytes.Buffer
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				buf.WriteString(sl.LogRecords().At(k).Body().AsString())
+				buf.WriteByte('\n')
+			}
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func (marshaler *bodyMarshaler) MarshalTraces(ptrace.Traces) ([]byte, error) {
+	return nil, fmt.Errorf("traces can't be marshaled into %s format", Body)
+}
+
+func (marshaler *bodyMarshaler) MarshalMetrics(pmetric.Metrics) ([]byte, error) {
+	return nil, fmt.Errorf("metrics can't be marshaled into %s format", Body)
+}
+
+func (marshaler *bodyMarshaler) format() string {
+	return "txt"
+}
@@ -3,6 +3,13 @@
 
 package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
 
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configcompression"
+)
+
 // S3UploaderConfig contains aws s3 uploader related config to controls things
 // like bucket, prefix, batching, connections, retries, etc.
 type S3UploaderConfig struct {
@@ -11,14 +18,32 @@ type S3UploaderConfig struct {
 	S3Prefix    string `mapstructure:"s3_prefix"`
 	S3Partition string `mapstructure:"s3_partition"`
 	FilePrefix  string `mapstructure:"file_prefix"`
+	// Endpoint overrides the S3 endpoint, to use S3 compatible object stores.
+	Endpoint string `mapstructure:"endpoint"`
+	// S3ForcePathStyle puts the bucket in the path of the object URLs rather than in the host name.
+	S3ForcePathStyle bool `mapstructure:"s3_force_path_style"`
+	// DisableSSL makes the uploader talk plain HTTP to the endpoint.
+	DisableSSL bool `mapstructure:"disable_ssl"`
+	// Compression compresses the objects before they are uploaded, either gzip or zstd.
+	Compression configcompression.CompressionType `mapstructure:"compression"`
 }
 
 type MarshalerType string
 
 const (
-	OtlpJSON MarshalerType = "otlp_json"
+	OtlpJSON     MarshalerType = "otlp_json"
+	OtlpProtobuf MarshalerType = "otlp_proto"
+	// NDJSON writes one JSON document per log record, separated by newlines.
+	NDJSON MarshalerType = "ndjson"
+	// Body writes the body of each log record on its own line.
+	Body MarshalerType = "body"
 )
 
+// logsOnly returns whether the marshaler can only write logs.
+func (m MarshalerType) logsOnly() bool {
+	return m == NDJSON || m == Body
+}
+
 // Config contains the main configuration options for the s3 exporter
 type Config struct {
 	S3Uploader    S3UploaderConfig `mapstructure:"s3uploader"`
@@ -26,3 +51,20 @@ type Config struct {
 
 	FileFormat string `mapstructure:"file_format"`
 }
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid
+func (c *Config) Validate() error {
+	switch c.MarshalerName {
+	case OtlpJSON, OtlpProtobuf, NDJSON, Body:
+	default:
+		return fmt.Errorf("unknown marshaler %q", c.MarshalerName)
+	}
+	switch c.S3Uploader.Compression {
+	case "", "none", configcompression.Gzip, configcompression.Zstd:
+	default:
+		return fmt.Errorf("unsupported compression %q, only gzip and zstd are supported", c.S3Uploader.Compression)
+	}
+	return nil
+}
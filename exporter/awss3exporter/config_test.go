@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/otelcol/otelcoltest"
 )
 
@@ -62,3 +63,49 @@ func TestConfig(t *testing.T) {
 		},
 	)
 }
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(cfg *Config)
+		expectedErr string
+	}{
+		{
+			name:   "default",
+			modify: func(*Config) {},
+		},
+		{
+			name: "proto with zstd",
+			modify: func(cfg *Config) {
+				cfg.MarshalerName = OtlpProtobuf
+				cfg.S3Uploader.Compression = configcompression.Zstd
+			},
+		},
+		{
+			name: "unknown marshaler",
+			modify: func(cfg *Config) {
+				cfg.MarshalerName = "csv"
+			},
+			expectedErr: `unknown marshaler "csv"`,
+		},
+		{
+			name: "unsupported compression",
+			modify: func(cfg *Config) {
+				cfg.S3Uploader.Compression = configcompression.Snappy
+			},
+			expectedErr: `unsupported compression "snappy", only gzip and zstd are supported`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -5,6 +5,7 @@ package awss3exporter // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"fmt"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter"
@@ -52,6 +53,10 @@ func createMetricsExporter(ctx context.Context,
 	params exporter.CreateSettings,
 	config component.Config) (exporter.Metrics, error) {
 
+	if marshaler := config.(*Config).MarshalerName; marshaler.logsOnly() {
+		return nil, fmt.Errorf("marshaler %q only supports logs", marshaler)
+	}
+
 	s3Exporter, err := newS3Exporter(config.(*Config), params)
 	if err != nil {
 		return nil, err
@@ -66,6 +71,10 @@ func createTracesExporter(ctx context.Context,
 	params exporter.CreateSettings,
 	config component.Config) (exporter.Traces, error) {
 
+	if marshaler := config.(*Config).MarshalerName; marshaler.logsOnly() {
+		return nil, fmt.Errorf("marshaler %q only supports logs", marshaler)
+	}
+
 	s3Exporter, err := newS3Exporter(config.(*Config), params)
 	if err != nil {
 		return nil, err
@@ -48,3 +48,16 @@ func TestCreateLogsExporter(t *testing.T) {
 	assert.NoError(t, err)
 	require.NotNil(t, exp)
 }
+
+func TestCreateLogsOnlyMarshalerExporters(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MarshalerName = Body
+
+	_, err := createTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	assert.EqualError(t, err, `marshaler "body" only supports logs`)
+	_, err = createMetricsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	assert.EqualError(t, err, `marshaler "body" only supports logs`)
+	exp, err := createLogsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	assert.NoError(t, err)
+	require.NotNil(t, exp)
+}
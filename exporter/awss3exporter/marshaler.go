@@ -31,6 +31,15 @@ func NewMarshaler(mType MarshalerType, logger *zap.Logger) (marshaler, error) {
 		marshaler.tracesMarshaler = &ptrace.JSONMarshaler{}
 		marshaler.metricsMarshaler = &pmetric.JSONMarshaler{}
 		marshaler.fileFormat = "json"
+	case OtlpProtobuf:
+		marshaler.logsMarshaler = &plog.ProtoMarshaler{}
+		marshaler.tracesMarshaler = &ptrace.ProtoMarshaler{}
+		marshaler.metricsMarshaler = &pmetric.ProtoMarshaler{}
+		marshaler.fileFormat = "binpb"
+	case NDJSON:
+		return &ndjsonMarshaler{}, nil
+	case Body:
+		return &bodyMarshaler{}, nil
 	default:
 		return nil, ErrUnknownMarshaler
 	}
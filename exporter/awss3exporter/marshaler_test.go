@@ -5,9 +5,14 @@ package awss3exporter
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 )
 
@@ -18,9 +23,85 @@ func TestMarshaler(t *testing.T) {
 		require.NotNil(t, m)
 		assert.Equal(t, m.format(), "json")
 	}
+	{
+		m, err := NewMarshaler("otlp_proto", zap.NewNop())
+		assert.NoError(t, err)
+		require.NotNil(t, m)
+		assert.Equal(t, m.format(), "binpb")
+	}
+	{
+		m, err := NewMarshaler("ndjson", zap.NewNop())
+		assert.NoError(t, err)
+		require.NotNil(t, m)
+		assert.Equal(t, m.format(), "json")
+	}
+	{
+		m, err := NewMarshaler("body", zap.NewNop())
+		assert.NoError(t, err)
+		require.NotNil(t, m)
+		assert.Equal(t, m.format(), "txt")
+	}
 	{
 		m, err := NewMarshaler("unknown", zap.NewNop())
 		assert.Error(t, err)
 		require.Nil(t, m)
 	}
 }
+
+func TestProtoMarshaler(t *testing.T) {
+	m, err := NewMarshaler(OtlpProtobuf, zap.NewNop())
+	require.NoError(t, err)
+
+	logs := getTestLogs(t)
+	buf, err := m.MarshalLogs(logs)
+	require.NoError(t, err)
+	unmarshaled, err := (&plog.ProtoUnmarshaler{}).UnmarshalLogs(buf)
+	require.NoError(t, err)
+	assert.Equal(t, logs, unmarshaled)
+}
+
+func newMarshalerTestLogs() plog.Logs {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("app")
+
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(time.Date(2023, 5, 4, 10, 30, 0, 500, time.UTC)))
+	lr.SetSeverityText("INFO")
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	lr.Body().SetStr("order placed")
+	lr.Attributes().PutInt("order.items", 3)
+	lr.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+
+	lr = sl.LogRecords().AppendEmpty()
+	lr.Body().SetEmptyMap().PutStr("event", "payment")
+	return logs
+}
+
+func TestNDJSONMarshaler(t *testing.T) {
+	m := &ndjsonMarshaler{}
+	buf, err := m.MarshalLogs(newMarshalerTestLogs())
+	require.NoError(t, err)
+	assert.Equal(t, `{"timestamp":"2023-05-04T10:30:00.0000005Z","severity_text":"INFO","severity_number":9,"body":"order placed","attributes":{"order.items":3},"trace_id":"0102030405060708090a0b0c0d0e0f10","resource":{"service.name":"checkout"},"scope_name":"app"}
+{"body":{"event":"payment"},"resource":{"service.name":"checkout"},"scope_name":"app"}
+`, string(buf))
+
+	_, err = m.MarshalTraces(ptrace.NewTraces())
+	assert.EqualError(t, err, "traces can't be marshaled into ndjson format")
+	_, err = m.MarshalMetrics(pmetric.NewMetrics())
+	assert.EqualError(t, err, "metrics can't be marshaled into ndjson format")
+}
+
+func TestBodyMarshaler(t *testing.T) {
+	m := &bodyMarshaler{}
+	buf, err := m.MarshalLogs(newMarshalerTestLogs())
+	require.NoError(t, err)
+	assert.Equal(t, "order placed\n{\"event\":\"payment\"}\n", string(buf))
+
+	_, err = m.MarshalTraces(ptrace.NewTraces())
+	assert.EqualError(t, err, "traces can't be marshaled into body format")
+	_, err = m.MarshalMetrics(pmetric.NewMetrics())
+	assert.EqualError(t, err, "metrics can't be marshaled into body format")
+}
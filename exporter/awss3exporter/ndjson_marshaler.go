@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// ndjsonRecord is the flattened form of a log record, with its resource and scope,
+// so that every line of the object can be queried on its own.
+type ndjsonRecord struct {
+	Timestamp          string         `json:"timestamp,omitempty"`
+	ObservedTimestamp  string         `json:"observed_timestamp,omitempty"`
+	SeverityText       string         `json:"severity_text,omitempty"`
+	SeverityNumber     int32          `json:"severity_number,omitempty"`
+	Body               any            `json:"body"`
+	Attributes         map[string]any `json:"attributes,omitempty"`
+	TraceID            string         `json:"trace_id,omitempty"`
+	SpanID             string         `json:"span_id,omitempty"`
+	ResourceAttributes map[string]any `json:"resource,omitempty"`
+	ScopeName          string         `json:"scope_name,omitempty"`
+	ScopeVersion       string         `json:"scope_version,omitempty"`
+}
+
+type ndjsonMarshaler struct{}
+
+func (marshaler *ndjsonMarshaler) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		resourceAttributes := rl.Resource().Attributes().AsRaw()
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				record := ndjsonRecord{
+					Timestamp:          formatTimestamp(lr.Timestamp().AsTime(), lr.Timestamp() != 0),
+					ObservedTimestamp:  formatTimestamp(lr.ObservedTimestamp().AsTime(), lr.ObservedTimestamp() != 0),
+					SeverityText:       lr.SeverityText(),
+					SeverityNumber:     int32(lr.SeverityNumber()),
+					Body:               lr.Body().AsRaw(),
+					Attributes:         lr.Attributes().AsRaw(),
+					ResourceAttributes: resourceAttributes,
+					ScopeName:          sl.Scope().Name(),
+					ScopeVersion:       sl.Scope().Version(),
+				}
+				if !lr.TraceID().IsEmpty() {
+					record.TraceID = lr.TraceID().String()
+				}
+				if !lr.SpanID().IsEmpty() {
+					record.SpanID = lr.SpanID().String()
+				}
+				// the encoder ends every document with a newline
+				if err := encoder.Encode(record); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func (marshaler *ndjsonMarshaler) MarshalTraces(ptrace.Traces) ([]byte, error) {
+	return nil, fmt.Errorf("traces can't be marshaled into %s format", NDJSON)
+}
+
+func (marshaler *ndjsonMarshaler) MarshalMetrics(pmetric.Metrics) ([]byte, error) {
+	return nil, fmt.Errorf("metrics can't be marshaled into %s format", NDJSON)
+}
+
+func (marshaler *ndjsonMarshaler) format() string {
+	return "json"
+}
+
+func formatTimestamp(t time.Time, set bool) string {
+	if !set {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -5,6 +5,7 @@ package awss3exporter // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"math/rand"
@@ -14,6 +15,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/klauspost/compress/zstd"
+	"go.opentelemetry.io/collector/config/configcompression"
 )
 
 type s3Writer struct {
@@ -46,18 +49,55 @@ func getS3Key(time time.Time, keyPrefix string, partition string, filePrefix str
 	return s3Key
 }
 
+// compress compresses the buffer with the given compression, and returns
+// the extension of the compressed objects.
+func compress(buf []byte, compression configcompression.CompressionType) ([]byte, string, error) {
+	switch compression {
+	case configcompression.Gzip:
+		var compressed bytes.Buffer
+		writer := gzip.NewWriter(&compressed)
+		if _, err := writer.Write(buf); err != nil {
+			return nil, "", err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, "", err
+		}
+		return compressed.Bytes(), ".gz", nil
+	case configcompression.Zstd:
+		encoder, err := zstd.NewWriter(nil)
+		if err != nil {
+			return nil, "", err
+		}
+		defer encoder.Close()
+		return encoder.EncodeAll(buf, nil), ".zst", nil
+	default:
+		return buf, "", nil
+	}
+}
+
 func (s3writer *s3Writer) writeBuffer(ctx context.Context, buf []byte, config *Config, metadata string, format string) error {
+	buf, extension, err := compress(buf, config.S3Uploader.Compression)
+	if err != nil {
+		return err
+	}
+
 	now := time.Now()
 	key := getS3Key(now,
 		config.S3Uploader.S3Prefix, config.S3Uploader.S3Partition,
-		config.S3Uploader.FilePrefix, metadata, format)
+		config.S3Uploader.FilePrefix, metadata, format+extension)
 
 	// create a reader from data data in memory
 	reader := bytes.NewReader(buf)
 
-	sess, err := session.NewSession(&aws.Config{
-		Region: aws.String(config.S3Uploader.Region)},
-	)
+	awsConfig := &aws.Config{
+		Region:           aws.String(config.S3Uploader.Region),
+		S3ForcePathStyle: aws.Bool(config.S3Uploader.S3ForcePathStyle),
+		DisableSSL:       aws.Bool(config.S3Uploader.DisableSSL),
+	}
+	if config.S3Uploader.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.S3Uploader.Endpoint)
+	}
+	sess, err := session.NewSession(awsConfig)
 
 	if err != nil {
 		return err
@@ -65,11 +105,15 @@ func (s3writer *s3Writer) writeBuffer(ctx context.Context, buf []byte, config *C
 
 	uploader := s3manager.NewUploader(sess)
 
-	_, err = uploader.Upload(&s3manager.UploadInput{
+	input := &s3manager.UploadInput{
 		Bucket: aws.String(config.S3Uploader.S3Bucket),
 		Key:    aws.String(key),
 		Body:   reader,
-	})
+	}
+	if configcompression.IsCompressed(config.S3Uploader.Compression) {
+		input.ContentEncoding = aws.String(string(config.S3Uploader.Compression))
+	}
+	_, err = uploader.UploadWithContext(ctx, input)
 	if err != nil {
 		return err
 	}
@@ -4,12 +4,21 @@
 package awss3exporter
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/configcompression"
 )
 
 func TestS3TimeKey(t *testing.T) {
@@ -39,3 +48,109 @@ func TestS3Key(t *testing.T) {
 	matched := re.MatchString(s3Key)
 	assert.Equal(t, true, matched)
 }
+
+func decompress(t *testing.T, buf []byte, compression configcompression.CompressionType) []byte {
+	switch compression {
+	case configcompression.Gzip:
+		reader, err := gzip.NewReader(bytes.NewReader(buf))
+		require.NoError(t, err)
+		decompressed, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		return decompressed
+	case configcompression.Zstd:
+		decoder, err := zstd.NewReader(nil)
+		require.NoError(t, err)
+		defer decoder.Close()
+		decompressed, err := decoder.DecodeAll(buf, nil)
+		require.NoError(t, err)
+		return decompressed
+	default:
+		return buf
+	}
+}
+
+func TestCompress(t *testing.T) {
+	tests := []struct {
+		compression configcompression.CompressionType
+		extension   string
+	}{
+		{compression: "", extension: ""},
+		{compression: "none", extension: ""},
+		{compression: configcompression.Gzip, extension: ".gz"},
+		{compression: configcompression.Zstd, extension: ".zst"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.compression), func(t *testing.T) {
+			compressed, extension, err := compress(testLogs, tt.compression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.extension, extension)
+			assert.Equal(t, testLogs, decompress(t, compressed, tt.compression))
+		})
+	}
+}
+
+// s3Object is an object uploaded to the fake S3 server.
+type s3Object struct {
+	path            string
+	contentEncoding string
+	body            []byte
+}
+
+// newFakeS3Server returns a server standing in for S3, which records the uploaded objects.
+func newFakeS3Server(t *testing.T) (*httptest.Server, func() []s3Object) {
+	var mu sync.Mutex
+	var objects []s3Object
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		mu.Lock()
+		objects = append(objects, s3Object{path: r.URL.Path, contentEncoding: r.Header.Get("Content-Encoding"), body: body})
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []s3Object {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]s3Object(nil), objects...)
+	}
+}
+
+func TestWriteBuffer(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	tests := []struct {
+		compression configcompression.CompressionType
+		keyPattern  string
+	}{
+		{compression: "", keyPattern: `^/databucket/logs/year=\d{4}/month=\d{2}/day=\d{2}/hour=\d{2}/minute=\d{2}/applogs_\d+\.json$`},
+		{compression: configcompression.Gzip, keyPattern: `^/databucket/logs/.*/applogs_\d+\.json\.gz$`},
+		{compression: configcompression.Zstd, keyPattern: `^/databucket/logs/.*/applogs_\d+\.json\.zst$`},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.compression), func(t *testing.T) {
+			server, objects := newFakeS3Server(t)
+			config := createDefaultConfig().(*Config)
+			config.S3Uploader.S3Bucket = "databucket"
+			config.S3Uploader.S3Prefix = "logs"
+			config.S3Uploader.FilePrefix = "app"
+			config.S3Uploader.Endpoint = server.URL
+			config.S3Uploader.S3ForcePathStyle = true
+			config.S3Uploader.DisableSSL = true
+			config.S3Uploader.Compression = tt.compression
+
+			require.NoError(t, (&s3Writer{}).writeBuffer(context.Background(), testLogs, config, "logs", "json"))
+
+			uploaded := objects()
+			require.Len(t, uploaded, 1)
+			assert.Regexp(t, tt.keyPattern, uploaded[0].path)
+			assert.Equal(t, string(tt.compression), uploaded[0].contentEncoding)
+			assert.Equal(t, testLogs, decompress(t, uploaded[0].body, tt.compression))
+		})
+	}
+}
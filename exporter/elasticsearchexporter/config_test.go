@@ -31,12 +31,13 @@ func TestLoad_DeprecatedIndexConfigOption(t *testing.T) {
 			NumConsumers: exporterhelper.NewDefaultQueueSettings().NumConsumers,
 			QueueSize:    exporterhelper.NewDefaultQueueSettings().QueueSize,
 		},
-		Endpoints:   []string{"http://localhost:9200"},
-		CloudID:     "TRNMxjXlNJEt",
-		Index:       "my_log_index",
-		LogsIndex:   "logs-generic-default",
-		TracesIndex: "traces-generic-default",
-		Pipeline:    "mypipeline",
+		Endpoints:    []string{"http://localhost:9200"},
+		CloudID:      "TRNMxjXlNJEt",
+		Index:        "my_log_index",
+		LogsIndex:    "logs-generic-default",
+		TracesIndex:  "traces-generic-default",
+		MetricsIndex: "metrics-generic-default",
+		Pipeline:     "mypipeline",
 		HTTPClientSettings: HTTPClientSettings{
 			Authentication: AuthenticationSettings{
 				User:     "elastic",
@@ -93,12 +94,13 @@ func TestLoadConfig(t *testing.T) {
 					NumConsumers: exporterhelper.NewDefaultQueueSettings().NumConsumers,
 					QueueSize:    exporterhelper.NewDefaultQueueSettings().QueueSize,
 				},
-				Endpoints:   []string{"https://elastic.example.com:9200"},
-				CloudID:     "TRNMxjXlNJEt",
-				Index:       "",
-				LogsIndex:   "logs-generic-default",
-				TracesIndex: "trace_index",
-				Pipeline:    "mypipeline",
+				Endpoints:    []string{"https://elastic.example.com:9200"},
+				CloudID:      "TRNMxjXlNJEt",
+				Index:        "",
+				LogsIndex:    "logs-generic-default",
+				TracesIndex:  "trace_index",
+				MetricsIndex: "metrics-generic-default",
+				Pipeline:     "mypipeline",
 				HTTPClientSettings: HTTPClientSettings{
 					Authentication: AuthenticationSettings{
 						User:     "elastic",
@@ -138,12 +140,13 @@ func TestLoadConfig(t *testing.T) {
 					NumConsumers: exporterhelper.NewDefaultQueueSettings().NumConsumers,
 					QueueSize:    exporterhelper.NewDefaultQueueSettings().QueueSize,
 				},
-				Endpoints:   []string{"http://localhost:9200"},
-				CloudID:     "TRNMxjXlNJEt",
-				Index:       "",
-				LogsIndex:   "my_log_index",
-				TracesIndex: "traces-generic-default",
-				Pipeline:    "mypipeline",
+				Endpoints:    []string{"http://localhost:9200"},
+				CloudID:      "TRNMxjXlNJEt",
+				Index:        "",
+				LogsIndex:    "my_log_index",
+				TracesIndex:  "traces-generic-default",
+				MetricsIndex: "metrics-generic-default",
+				Pipeline:     "mypipeline",
 				HTTPClientSettings: HTTPClientSettings{
 					Authentication: AuthenticationSettings{
 						User:     "elastic",
@@ -174,6 +177,23 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id:         component.NewIDWithName(typeStr, "metric"),
+			configFile: "config.yaml",
+			expected: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://localhost:9200"}
+				cfg.MetricsIndex = "my_metric_index"
+				cfg.MetricsDynamicIndex.Enabled = true
+			}),
+		},
+		{
+			id:         component.NewIDWithName(typeStr, "otel"),
+			configFile: "config.yaml",
+			expected: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://localhost:9200"}
+				cfg.Mapping.Mode = "otel"
+			}),
+		},
 	}
 
 	for _, tt := range tests {
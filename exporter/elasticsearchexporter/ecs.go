@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	semconv "go.opentelemetry.io/collector/semconv/v1.18.0"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter/internal/objmodel"
+)
+
+// k8sClusterUID is not part of the semantic conventions yet, but is set by the k8sattributes processor.
+const k8sClusterUID = "k8s.cluster.uid"
+
+// resourceAttrsConversionMap maps the resource attributes defined in the semantic conventions
+// to their ECS fields.
+var resourceAttrsConversionMap = map[string]string{
+	semconv.AttributeServiceInstanceID:     "service.node.name",
+	semconv.AttributeDeploymentEnvironment: "service.environment",
+	semconv.AttributeHostName:              "host.hostname",
+	semconv.AttributeHostArch:              "host.architecture",
+	semconv.AttributeOSType:                "host.os.platform",
+	semconv.AttributeOSDescription:         "host.os.full",
+	semconv.AttributeOSName:                "host.os.name",
+	semconv.AttributeOSVersion:             "host.os.version",
+	semconv.AttributeCloudPlatform:         "cloud.service.name",
+	semconv.AttributeK8SClusterName:        "orchestrator.cluster.name",
+	k8sClusterUID:                          "orchestrator.cluster.id",
+	semconv.AttributeK8SNamespaceName:      "orchestrator.namespace",
+	semconv.AttributeK8SPodName:            "orchestrator.resource.name",
+	semconv.AttributeK8SPodUID:             "orchestrator.resource.id",
+}
+
+// logAttrsConversionMap maps the log record attributes defined in the semantic conventions
+// to their ECS fields.
+var logAttrsConversionMap = map[string]string{
+	semconv.AttributeExceptionMessage:    "error.message",
+	semconv.AttributeExceptionType:       "error.type",
+	semconv.AttributeExceptionStacktrace: "error.stack_trace",
+}
+
+// spanAttrsConversionMap maps the span attributes defined in the semantic conventions
+// to their ECS fields.
+var spanAttrsConversionMap = map[string]string{
+	semconv.AttributeHTTPMethod:     "http.request.method",
+	semconv.AttributeHTTPStatusCode: "http.response.status_code",
+	semconv.AttributeHTTPURL:        "url.full",
+	semconv.AttributeHTTPUserAgent:  "user_agent.original",
+}
+
+func encodeLogECSMode(resource pcommon.Resource, record plog.LogRecord) objmodel.Document {
+	var document objmodel.Document
+	timestamp := record.Timestamp()
+	if timestamp == 0 {
+		timestamp = record.ObservedTimestamp()
+	}
+	document.AddTimestamp("@timestamp", timestamp)
+	document.AddTraceID("trace.id", record.TraceID())
+	document.AddSpanID("span.id", record.SpanID())
+	document.AddString("log.level", record.SeverityText())
+	if record.SeverityNumber() != plog.SeverityNumberUnspecified {
+		document.AddInt("event.severity", int64(record.SeverityNumber()))
+	}
+	document.AddAttribute("message", record.Body())
+	addECSAttributes(&document, record.Attributes(), logAttrsConversionMap)
+	addECSResource(&document, resource)
+	return document
+}
+
+func encodeSpanECSMode(resource pcommon.Resource, span ptrace.Span) objmodel.Document {
+	var document objmodel.Document
+	document.AddTimestamp("@timestamp", span.StartTimestamp())
+	document.AddTraceID("trace.id", span.TraceID())
+	document.AddSpanID("span.id", span.SpanID())
+	document.AddSpanID("parent.id", span.ParentSpanID())
+	document.AddString("span.name", span.Name())
+	document.AddString("span.kind", span.Kind().String())
+	document.AddInt("event.duration", int64(span.EndTimestamp()-span.StartTimestamp()))
+	document.AddString("event.outcome", spanOutcome(span.Status()))
+	addECSAttributes(&document, span.Attributes(), spanAttrsConversionMap)
+	addECSResource(&document, resource)
+	return document
+}
+
+// addECSResource adds the resource attributes to the document, converting the ones having
+// an ECS equivalent and describing the Kubernetes resources as an ECS orchestrator.
+func addECSResource(document *objmodel.Document, resource pcommon.Resource) {
+	attrs := resource.Attributes()
+	addECSAttributes(document, attrs, resourceAttrsConversionMap)
+
+	// host.name is both the name of the host and its hostname in ECS.
+	if hostName, ok := attrs.Get(semconv.AttributeHostName); ok {
+		document.AddAttribute("host.name", hostName)
+	}
+	if _, ok := attrs.Get(semconv.AttributeK8SPodName); ok {
+		document.AddString("orchestrator.resource.type", "pod")
+	}
+	isK8s := false
+	attrs.Range(func(k string, _ pcommon.Value) bool {
+		isK8s = strings.HasPrefix(k, "k8s.")
+		return !isK8s
+	})
+	if isK8s {
+		document.AddString("orchestrator.type", "kubernetes")
+	}
+}
+
+// addECSAttributes adds the attributes to the document, at the top level, renaming the ones
+// found in the conversion map to their ECS field. The ECS fields are added last, so that
+// they take precedence over conflicting attributes on deduplication.
+func addECSAttributes(document *objmodel.Document, attrs pcommon.Map, conversionMap map[string]string) {
+	mapped := pcommon.NewMap()
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		if ecsKey, ok := conversionMap[k]; ok {
+			v.CopyTo(mapped.PutEmpty(ecsKey))
+			return true
+		}
+		document.AddAttribute(k, v)
+		return true
+	})
+	document.AddAttributes("", mapped)
+}
+
+// spanOutcome converts the status of the span into an ECS event outcome.
+func spanOutcome(status ptrace.Status) string {
+	switch status.Code() {
+	case ptrace.StatusCodeOk:
+		return "success"
+	case ptrace.StatusCodeError:
+		return "failure"
+	default:
+		return "unknown"
+	}
+}
@@ -15,9 +15,10 @@ import (
 
 const (
 	// The value of "type" key in configuration.
-	typeStr            = "elasticsearch"
-	defaultLogsIndex   = "logs-generic-default"
-	defaultTracesIndex = "traces-generic-default"
+	typeStr             = "elasticsearch"
+	defaultLogsIndex    = "logs-generic-default"
+	defaultTracesIndex  = "traces-generic-default"
+	defaultMetricsIndex = "metrics-generic-default"
 	// The stability level of the exporter.
 	stability = component.StabilityLevelBeta
 )
@@ -29,6 +30,7 @@ func NewFactory() exporter.Factory {
 		createDefaultConfig,
 		exporter.WithLogs(createLogsExporter, stability),
 		exporter.WithTraces(createTracesExporter, stability),
+		exporter.WithMetrics(createMetricsExporter, stability),
 	)
 }
 
@@ -40,9 +42,10 @@ func createDefaultConfig() component.Config {
 		HTTPClientSettings: HTTPClientSettings{
 			Timeout: 90 * time.Second,
 		},
-		Index:       "",
-		LogsIndex:   defaultLogsIndex,
-		TracesIndex: defaultTracesIndex,
+		Index:        "",
+		LogsIndex:    defaultLogsIndex,
+		TracesIndex:  defaultTracesIndex,
+		MetricsIndex: defaultMetricsIndex,
 		Retry: RetrySettings{
 			Enabled:         true,
 			MaxRequests:     3,
@@ -102,3 +105,25 @@ func createTracesExporter(ctx context.Context,
 		exporterhelper.WithShutdown(exporter.Shutdown),
 		exporterhelper.WithQueue(cf.QueueSettings))
 }
+
+// createMetricsExporter creates a new exporter for metrics.
+//
+// Data points sharing their timestamp and attributes are indexed as one document.
+func createMetricsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config,
+) (exporter.Metrics, error) {
+	cf := cfg.(*Config)
+	exporter, err := newMetricsExporter(set.Logger, cf)
+	if err != nil {
+		return nil, fmt.Errorf("cannot configure Elasticsearch metrics exporter: %w", err)
+	}
+	return exporterhelper.NewMetricsExporter(
+		ctx,
+		set,
+		cfg,
+		exporter.pushMetricsData,
+		exporterhelper.WithShutdown(exporter.Shutdown),
+		exporterhelper.WithQueue(cf.QueueSettings))
+}
@@ -51,8 +51,7 @@ func newLogsExporter(logger *zap.Logger, cfg *Config) (*elasticsearchLogsExporte
 		maxAttempts = cfg.Retry.MaxRequests
 	}
 
-	// TODO: Apply encoding and field mapping settings.
-	model := &encodeModel{dedup: true, dedot: false}
+	model := newEncodeModel(cfg)
 
 	indexStr := cfg.LogsIndex
 	if cfg.Index != "" {
@@ -76,6 +75,11 @@ func (e *elasticsearchLogsExporter) Shutdown(ctx context.Context) error {
 }
 
 func (e *elasticsearchLogsExporter) pushLogsData(ctx context.Context, ld plog.Logs) error {
+	model, err := modelForRequest(ctx, e.model)
+	if err != nil {
+		return err
+	}
+
 	var errs []error
 
 	rls := ld.ResourceLogs()
@@ -84,9 +88,10 @@ func (e *elasticsearchLogsExporter) pushLogsData(ctx context.Context, ld plog.Lo
 		resource := rl.Resource()
 		ills := rl.ScopeLogs()
 		for j := 0; j < ills.Len(); j++ {
+			scope := ills.At(j).Scope()
 			logs := ills.At(j).LogRecords()
 			for k := 0; k < logs.Len(); k++ {
-				if err := e.pushLogRecord(ctx, resource, logs.At(k)); err != nil {
+				if err := e.pushLogRecord(ctx, model, resource, scope, logs.At(k)); err != nil {
 					if cerr := ctx.Err(); cerr != nil {
 						return cerr
 					}
@@ -100,7 +105,7 @@ func (e *elasticsearchLogsExporter) pushLogsData(ctx context.Context, ld plog.Lo
 	return multierr.Combine(errs...)
 }
 
-func (e *elasticsearchLogsExporter) pushLogRecord(ctx context.Context, resource pcommon.Resource, record plog.LogRecord) error {
+func (e *elasticsearchLogsExporter) pushLogRecord(ctx context.Context, model mappingModel, resource pcommon.Resource, scope pcommon.InstrumentationScope, record plog.LogRecord) error {
 	fIndex := e.index
 	if e.dynamicIndex {
 		prefix := getFromBothResourceAndAttribute(indexPrefix, resource, record)
@@ -109,7 +114,7 @@ func (e *elasticsearchLogsExporter) pushLogRecord(ctx context.Context, resource
 		fIndex = fmt.Sprintf("%s%s%s", prefix, fIndex, suffix)
 	}
 
-	document, err := e.model.encodeLog(resource, record)
+	document, err := model.encodeLog(resource, scope, record)
 	if err != nil {
 		return fmt.Errorf("Failed to encode log event: %w", err)
 	}
@@ -392,6 +392,6 @@ func mustSendLogsWithAttributes(t *testing.T, exporter *elasticsearchLogsExporte
 	resSpans := logs.ResourceLogs().At(0)
 	logRecords := resSpans.ScopeLogs().At(0).LogRecords().At(0)
 
-	err := exporter.pushLogRecord(context.TODO(), resSpans.Resource(), logRecords)
+	err := exporter.pushLogRecord(context.TODO(), exporter.model, resSpans.Resource(), resSpans.ScopeLogs().At(0).Scope(), logRecords)
 	require.NoError(t, err)
 }
@@ -0,0 +1,308 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter"
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+type elasticsearchMetricsExporter struct {
+	logger *zap.Logger
+
+	index        string
+	dynamicIndex bool
+	maxAttempts  int
+
+	client      *esClientCurrent
+	bulkIndexer esBulkIndexerCurrent
+	model       mappingModel
+}
+
+func newMetricsExporter(logger *zap.Logger, cfg *Config) (*elasticsearchMetricsExporter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	client, err := newElasticsearchClient(logger, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	bulkIndexer, err := newBulkIndexer(logger, client, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	maxAttempts := 1
+	if cfg.Retry.Enabled {
+		maxAttempts = cfg.Retry.MaxRequests
+	}
+
+	model := newEncodeModel(cfg)
+
+	return &elasticsearchMetricsExporter{
+		logger:      logger,
+		client:      client,
+		bulkIndexer: bulkIndexer,
+
+		index:        cfg.MetricsIndex,
+		dynamicIndex: cfg.MetricsDynamicIndex.Enabled,
+		maxAttempts:  maxAttempts,
+		model:        model,
+	}, nil
+}
+
+func (e *elasticsearchMetricsExporter) Shutdown(ctx context.Context) error {
+	return e.bulkIndexer.Close(ctx)
+}
+
+// dataPoint is implemented by the data points of all the metric types.
+type dataPoint interface {
+	Timestamp() pcommon.Timestamp
+	Attributes() pcommon.Map
+}
+
+// dataPointGroup holds the values of the data points of a resource sharing their
+// timestamp and attributes, which are indexed as a single document.
+type dataPointGroup struct {
+	index      string
+	timestamp  pcommon.Timestamp
+	attributes pcommon.Map
+	values     []metricValue
+}
+
+type metricValue struct {
+	name  string
+	value pcommon.Value
+}
+
+type dataPointKey struct {
+	index      string
+	timestamp  pcommon.Timestamp
+	attributes [16]byte
+}
+
+// dataPointGroups groups the data points of a resource, keeping the groups in
+// the order they are created in.
+type dataPointGroups struct {
+	byKey   map[dataPointKey]*dataPointGroup
+	ordered []*dataPointGroup
+}
+
+func (g *dataPointGroups) add(index string, dp dataPoint, name string, value pcommon.Value) {
+	key := dataPointKey{index: index, timestamp: dp.Timestamp(), attributes: pdatautil.MapHash(dp.Attributes())}
+	group, ok := g.byKey[key]
+	if !ok {
+		group = &dataPointGroup{index: index, timestamp: dp.Timestamp(), attributes: dp.Attributes()}
+		g.byKey[key] = group
+		g.ordered = append(g.ordered, group)
+	}
+	group.values = append(group.values, metricValue{name: name, value: value})
+}
+
+func (e *elasticsearchMetricsExporter) pushMetricsData(ctx context.Context, md pmetric.Metrics) error {
+	model, err := modelForRequest(ctx, e.model)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		resource := rm.Resource()
+		groups := &dataPointGroups{byKey: map[dataPointKey]*dataPointGroup{}}
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				e.groupDataPoints(groups, resource, metrics.At(k))
+			}
+		}
+
+		for _, group := range groups.ordered {
+			if err := e.pushDataPointGroup(ctx, model, resource, group); err != nil {
+				if cerr := ctx.Err(); cerr != nil {
+					return cerr
+				}
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return multierr.Combine(errs...)
+}
+
+// groupDataPoints adds the values of the data points of the metric to their groups.
+func (e *elasticsearchMetricsExporter) groupDataPoints(groups *dataPointGroups, resource pcommon.Resource, metric pmetric.Metric) {
+	add := func(dp dataPoint, value pcommon.Value) {
+		groups.add(e.dataPointIndex(resource, dp), dp, metric.Name(), value)
+	}
+
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if dp := dps.At(i); !dp.Flags().NoRecordedValue() {
+				add(dp, numberValue(dp))
+			}
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if dp := dps.At(i); !dp.Flags().NoRecordedValue() {
+				add(dp, numberValue(dp))
+			}
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if dp := dps.At(i); !dp.Flags().NoRecordedValue() {
+				add(dp, histogramValue(dp))
+			}
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if dp := dps.At(i); !dp.Flags().NoRecordedValue() {
+				add(dp, exponentialHistogramValue(dp))
+			}
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if dp := dps.At(i); !dp.Flags().NoRecordedValue() {
+				add(dp, summaryValue(dp))
+			}
+		}
+	default:
+		e.logger.Debug("Dropping metric of unsupported type", zap.String("name", metric.Name()), zap.String("type", metric.Type().String()))
+	}
+}
+
+func (e *elasticsearchMetricsExporter) dataPointIndex(resource pcommon.Resource, dp dataPoint) string {
+	fIndex := e.index
+	if e.dynamicIndex {
+		prefix := getFromBothResourceAndAttribute(indexPrefix, resource, dp)
+		suffix := getFromBothResourceAndAttribute(indexSuffix, resource, dp)
+
+		fIndex = fmt.Sprintf("%s%s%s", prefix, fIndex, suffix)
+	}
+	return fIndex
+}
+
+func (e *elasticsearchMetricsExporter) pushDataPointGroup(ctx context.Context, model mappingModel, resource pcommon.Resource, group *dataPointGroup) error {
+	document, err := model.encodeDataPoints(resource, group)
+	if err != nil {
+		return fmt.Errorf("Failed to encode metric data points: %w", err)
+	}
+	return pushDocuments(ctx, e.logger, group.index, document, e.bulkIndexer, e.maxAttempts)
+}
+
+func numberValue(dp pmetric.NumberDataPoint) pcommon.Value {
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return pcommon.NewValueInt(dp.IntValue())
+	}
+	return pcommon.NewValueDouble(dp.DoubleValue())
+}
+
+// histogramValue converts the buckets of the data point into the counts and values
+// of an Elasticsearch histogram field, using the midpoint of each bucket as its value.
+func histogramValue(dp pmetric.HistogramDataPoint) pcommon.Value {
+	bounds := dp.ExplicitBounds()
+	counts := dp.BucketCounts()
+	var values []float64
+	var valueCounts []uint64
+	for i := 0; i < counts.Len(); i++ {
+		count := counts.At(i)
+		if count == 0 {
+			continue
+		}
+		var value float64
+		switch {
+		case bounds.Len() == 0:
+			// a single bucket, the best estimate of its values is the mean
+			if dp.HasSum() && dp.Count() > 0 {
+				value = dp.Sum() / float64(dp.Count())
+			}
+		case i == 0:
+			value = bounds.At(0)
+		case i >= bounds.Len():
+			value = bounds.At(bounds.Len() - 1)
+		default:
+			value = (bounds.At(i-1) + bounds.At(i)) / 2
+		}
+		values = append(values, value)
+		valueCounts = append(valueCounts, count)
+	}
+	return newHistogramValue(values, valueCounts)
+}
+
+// exponentialHistogramValue converts the buckets of the data point into the counts and values
+// of an Elasticsearch histogram field, in ascending order of values.
+func exponentialHistogramValue(dp pmetric.ExponentialHistogramDataPoint) pcommon.Value {
+	scale := int(dp.Scale())
+	// bucketMidpoint returns the midpoint of the bucket of the given index, whose
+	// bounds are base^index and base^(index+1), with base = 2^(2^-scale)
+	bucketMidpoint := func(index int) float64 {
+		lower := math.Exp2(math.Ldexp(float64(index), -scale))
+		upper := math.Exp2(math.Ldexp(float64(index+1), -scale))
+		return (lower + upper) / 2
+	}
+
+	var values []float64
+	var valueCounts []uint64
+	negative := dp.Negative()
+	for i := negative.BucketCounts().Len() - 1; i >= 0; i-- {
+		if count := negative.BucketCounts().At(i); count > 0 {
+			values = append(values, -bucketMidpoint(int(negative.Offset())+i))
+			valueCounts = append(valueCounts, count)
+		}
+	}
+	if dp.ZeroCount() > 0 {
+		values = append(values, 0)
+		valueCounts = append(valueCounts, dp.ZeroCount())
+	}
+	positive := dp.Positive()
+	for i := 0; i < positive.BucketCounts().Len(); i++ {
+		if count := positive.BucketCounts().At(i); count > 0 {
+			values = append(values, bucketMidpoint(int(positive.Offset())+i))
+			valueCounts = append(valueCounts, count)
+		}
+	}
+	return newHistogramValue(values, valueCounts)
+}
+
+func newHistogramValue(values []float64, counts []uint64) pcommon.Value {
+	histogram := pcommon.NewValueMap()
+	valuesSlice := histogram.Map().PutEmptySlice("values")
+	valuesSlice.EnsureCapacity(len(values))
+	for _, value := range values {
+		valuesSlice.AppendEmpty().SetDouble(value)
+	}
+	countsSlice := histogram.Map().PutEmptySlice("counts")
+	countsSlice.EnsureCapacity(len(counts))
+	for _, count := range counts {
+		countsSlice.AppendEmpty().SetInt(int64(count))
+	}
+	return histogram
+}
+
+// summaryValue converts the data point into an Elasticsearch aggregate_metric_double field.
+func summaryValue(dp pmetric.SummaryDataPoint) pcommon.Value {
+	summary := pcommon.NewValueMap()
+	summary.Map().PutDouble("sum", dp.Sum())
+	summary.Map().PutInt("value_count", int64(dp.Count()))
+	return summary
+}
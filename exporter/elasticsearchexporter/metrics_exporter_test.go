@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestMetricsExporter_New(t *testing.T) {
+	_, err := newMetricsExporter(zaptest.NewLogger(t), withDefaultConfig())
+	require.ErrorIs(t, err, errConfigNoEndpoint)
+
+	exporter, err := newMetricsExporter(zaptest.NewLogger(t), withDefaultConfig(func(cfg *Config) {
+		cfg.Endpoints = []string{"test:9200"}
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, defaultMetricsIndex, exporter.index)
+	require.NoError(t, exporter.Shutdown(context.TODO()))
+}
+
+var testMetricsTimestamp = pcommon.NewTimestampFromTime(time.Date(2023, 5, 4, 10, 30, 0, 0, time.UTC))
+
+// newTestMetrics returns the metrics of a host, the two cpu metrics sharing the
+// attributes of their data points.
+func newTestMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("host.name", "web-1")
+	metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+
+	utilization := metrics.AppendEmpty()
+	utilization.SetName("system.cpu.utilization")
+	utilization.SetEmptyGauge()
+	for _, cpu := range []string{"cpu0", "cpu1"} {
+		dp := utilization.Gauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(testMetricsTimestamp)
+		dp.SetDoubleValue(0.5)
+		dp.Attributes().PutStr("cpu", cpu)
+	}
+	cpuTime := metrics.AppendEmpty()
+	cpuTime.SetName("system.cpu.time")
+	dp := cpuTime.SetEmptySum().DataPoints().AppendEmpty()
+	dp.SetTimestamp(testMetricsTimestamp)
+	dp.SetIntValue(42)
+	dp.Attributes().PutStr("cpu", "cpu0")
+
+	// stale data points are left out
+	stale := cpuTime.Sum().DataPoints().AppendEmpty()
+	stale.SetTimestamp(testMetricsTimestamp)
+	stale.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+	stale.Attributes().PutStr("cpu", "cpu2")
+	return md
+}
+
+func TestExporter_PushMetrics(t *testing.T) {
+	t.Run("publish data points sharing their dimensions as one document", func(t *testing.T) {
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			rec.Record(docs)
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestMetricsExporter(t, server.URL, func(cfg *Config) {
+			cfg.Mapping.Mode = "none"
+			cfg.Mapping.Dedot = false
+		})
+		require.NoError(t, exporter.pushMetricsData(context.TODO(), newTestMetrics()))
+
+		rec.WaitItems(2)
+		var documents []map[string]interface{}
+		for _, item := range rec.Items() {
+			var document map[string]interface{}
+			require.NoError(t, json.Unmarshal(item.Document, &document))
+			documents = append(documents, document)
+		}
+		sort.Slice(documents, func(i, j int) bool {
+			return documents[i]["Attributes.cpu"].(string) < documents[j]["Attributes.cpu"].(string)
+		})
+		assert.Equal(t, []map[string]interface{}{
+			{
+				"@timestamp":             "2023-05-04T10:30:00.000000000Z",
+				"system.cpu.utilization": 0.5,
+				"system.cpu.time":        42.0,
+				"Attributes.cpu":         "cpu0",
+				"Resource.host.name":     "web-1",
+			},
+			{
+				"@timestamp":             "2023-05-04T10:30:00.000000000Z",
+				"system.cpu.utilization": 0.5,
+				"Attributes.cpu":         "cpu1",
+				"Resource.host.name":     "web-1",
+			},
+		}, documents)
+	})
+
+	t.Run("publish with dynamic index", func(t *testing.T) {
+		rec := newBulkRecorder()
+		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
+			rec.Record(docs)
+			return itemsAllOK(docs)
+		})
+
+		exporter := newTestMetricsExporter(t, server.URL, func(cfg *Config) {
+			cfg.MetricsIndex = "someindex"
+			cfg.MetricsDynamicIndex.Enabled = true
+		})
+
+		md := newTestMetrics()
+		md.ResourceMetrics().At(0).Resource().Attributes().PutStr(indexPrefix, "resprefix-")
+		dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+		dps.At(0).Attributes().PutStr(indexSuffix, "-attrsuffix")
+		require.NoError(t, exporter.pushMetricsData(context.TODO(), md))
+
+		rec.WaitItems(3)
+		var indices []string
+		for _, item := range rec.Items() {
+			var action map[string]map[string]interface{}
+			require.NoError(t, json.Unmarshal(item.Action, &action))
+			indices = append(indices, action["create"]["_index"].(string))
+		}
+		sort.Strings(indices)
+		// the suffix splits the data points of cpu0 into two documents
+		assert.Equal(t, []string{"resprefix-someindex", "resprefix-someindex", "resprefix-someindex-attrsuffix"}, indices)
+	})
+}
+
+func TestHistogramValue(t *testing.T) {
+	dp := pmetric.NewHistogramDataPoint()
+	dp.ExplicitBounds().FromRaw([]float64{1, 5, 10})
+	dp.BucketCounts().FromRaw([]uint64{2, 0, 3, 4})
+	assert.Equal(t, map[string]interface{}{
+		"values": []interface{}{1.0, 7.5, 10.0},
+		"counts": []interface{}{int64(2), int64(3), int64(4)},
+	}, histogramValue(dp).Map().AsRaw())
+
+	single := pmetric.NewHistogramDataPoint()
+	single.BucketCounts().FromRaw([]uint64{4})
+	single.SetCount(4)
+	single.SetSum(10)
+	assert.Equal(t, map[string]interface{}{
+		"values": []interface{}{2.5},
+		"counts": []interface{}{int64(4)},
+	}, histogramValue(single).Map().AsRaw())
+}
+
+func TestExponentialHistogramValue(t *testing.T) {
+	dp := pmetric.NewExponentialHistogramDataPoint()
+	dp.SetScale(0)
+	dp.SetZeroCount(1)
+	// buckets (1, 2] and (2, 4]
+	dp.Positive().SetOffset(0)
+	dp.Positive().BucketCounts().FromRaw([]uint64{3, 5})
+	// buckets [-2, -1) and [-8, -4)
+	dp.Negative().SetOffset(0)
+	dp.Negative().BucketCounts().FromRaw([]uint64{2, 0, 6})
+	assert.Equal(t, map[string]interface{}{
+		"values": []interface{}{-6.0, -1.5, 0.0, 1.5, 3.0},
+		"counts": []interface{}{int64(6), int64(2), int64(1), int64(3), int64(5)},
+	}, exponentialHistogramValue(dp).Map().AsRaw())
+}
+
+func TestSummaryValue(t *testing.T) {
+	dp := pmetric.NewSummaryDataPoint()
+	dp.SetCount(3)
+	dp.SetSum(12.5)
+	assert.Equal(t, map[string]interface{}{
+		"sum":         12.5,
+		"value_count": int64(3),
+	}, summaryValue(dp).Map().AsRaw())
+}
+
+func newTestMetricsExporter(t *testing.T, url string, fns ...func(*Config)) *elasticsearchMetricsExporter {
+	exporter, err := newMetricsExporter(zaptest.NewLogger(t), withTestTracesExporterConfig(fns...)(url))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, exporter.Shutdown(context.TODO()))
+	})
+	return exporter
+}
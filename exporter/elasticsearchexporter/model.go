@@ -5,8 +5,13 @@ package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
 
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -15,13 +20,19 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/traceutil"
 )
 
+// mappingModeMetadataKey is the client metadata key selecting the mapping mode of a request,
+// overriding the configured mode.
+const mappingModeMetadataKey = "X-Elastic-Mapping-Mode"
+
 type mappingModel interface {
-	encodeLog(pcommon.Resource, plog.LogRecord) ([]byte, error)
-	encodeSpan(pcommon.Resource, ptrace.Span) ([]byte, error)
+	encodeLog(pcommon.Resource, pcommon.InstrumentationScope, plog.LogRecord) ([]byte, error)
+	encodeSpan(pcommon.Resource, pcommon.InstrumentationScope, ptrace.Span) ([]byte, error)
+	encodeDataPoints(pcommon.Resource, *dataPointGroup) ([]byte, error)
 }
 
 // encodeModel tries to keep the event as close to the original open telemetry semantics as is.
-// No fields will be mapped by default.
+// No fields will be mapped in the none mode, the ecs mode maps the fields to the Elastic Common
+// Schema, and the otel mode keeps the OpenTelemetry data model.
 //
 // Field deduplication and dedotting of attributes is supported by the encodeModel.
 //
@@ -29,6 +40,7 @@ type mappingModel interface {
 type encodeModel struct {
 	dedup bool
 	dedot bool
+	mode  MappingMode
 }
 
 const (
@@ -37,7 +49,47 @@ const (
 	attributeField = "attribute"
 )
 
-func (m *encodeModel) encodeLog(resource pcommon.Resource, record plog.LogRecord) ([]byte, error) {
+func newEncodeModel(cfg *Config) *encodeModel {
+	return &encodeModel{
+		dedup: cfg.Mapping.Dedup,
+		dedot: cfg.Mapping.Dedot,
+		mode:  cfg.MappingMode(),
+	}
+}
+
+// modelForRequest returns the model encoding the documents of a request, switching to the
+// mapping mode set in the client metadata of the request, if any.
+func modelForRequest(ctx context.Context, model mappingModel) (mappingModel, error) {
+	values := client.FromContext(ctx).Metadata.Get(mappingModeMetadataKey)
+	if len(values) == 0 {
+		return model, nil
+	}
+	mode, ok := mappingModes[strings.ToLower(values[0])]
+	if !ok {
+		return nil, consumererror.NewPermanent(fmt.Errorf("unknown mapping mode %q in the client metadata", values[0]))
+	}
+	if m, ok := model.(*encodeModel); ok && m.mode != mode {
+		requestModel := *m
+		requestModel.mode = mode
+		return &requestModel, nil
+	}
+	return model, nil
+}
+
+func (m *encodeModel) encodeLog(resource pcommon.Resource, scope pcommon.InstrumentationScope, record plog.LogRecord) ([]byte, error) {
+	var document objmodel.Document
+	switch m.mode {
+	case MappingECS:
+		document = encodeLogECSMode(resource, record)
+	case MappingOTel:
+		document = encodeLogOTelMode(resource, scope, record)
+	default:
+		document = encodeLogDefaultMode(resource, record)
+	}
+	return m.serialize(document)
+}
+
+func encodeLogDefaultMode(resource pcommon.Resource, record plog.LogRecord) objmodel.Document {
 	var document objmodel.Document
 	document.AddTimestamp("@timestamp", record.Timestamp()) // We use @timestamp in order to ensure that we can index if the default data stream logs template is used.
 	document.AddTraceID("TraceId", record.TraceID())
@@ -48,19 +100,44 @@ func (m *encodeModel) encodeLog(resource pcommon.Resource, record plog.LogRecord
 	document.AddAttribute("Body", record.Body())
 	document.AddAttributes("Attributes", record.Attributes())
 	document.AddAttributes("Resource", resource.Attributes())
+	return document
+}
 
-	if m.dedup {
-		document.Dedup()
-	} else if m.dedot {
-		document.Sort()
+// encodeLogOTelMode keeps the OpenTelemetry data model, with the attributes of the record,
+// of its scope and of its resource in separate objects.
+func encodeLogOTelMode(resource pcommon.Resource, scope pcommon.InstrumentationScope, record plog.LogRecord) objmodel.Document {
+	var document objmodel.Document
+	timestamp := record.Timestamp()
+	if timestamp == 0 {
+		timestamp = record.ObservedTimestamp()
 	}
+	document.AddTimestamp("@timestamp", timestamp)
+	document.AddTimestamp("observed_timestamp", record.ObservedTimestamp())
+	document.AddTraceID("trace_id", record.TraceID())
+	document.AddSpanID("span_id", record.SpanID())
+	document.AddInt("flags", int64(record.Flags()))
+	document.AddString("severity_text", record.SeverityText())
+	document.AddInt("severity_number", int64(record.SeverityNumber()))
+	document.AddAttribute("body", record.Body())
+	document.AddAttributes("attributes", record.Attributes())
+	addOTelScopeAndResource(&document, resource, scope)
+	return document
+}
 
-	var buf bytes.Buffer
-	err := document.Serialize(&buf, m.dedot)
-	return buf.Bytes(), err
+func (m *encodeModel) encodeSpan(resource pcommon.Resource, scope pcommon.InstrumentationScope, span ptrace.Span) ([]byte, error) {
+	var document objmodel.Document
+	switch m.mode {
+	case MappingECS:
+		document = encodeSpanECSMode(resource, span)
+	case MappingOTel:
+		document = encodeSpanOTelMode(resource, scope, span)
+	default:
+		document = encodeSpanDefaultMode(resource, span)
+	}
+	return m.serialize(document)
 }
 
-func (m *encodeModel) encodeSpan(resource pcommon.Resource, span ptrace.Span) ([]byte, error) {
+func encodeSpanDefaultMode(resource pcommon.Resource, span ptrace.Span) objmodel.Document {
 	var document objmodel.Document
 	document.AddTimestamp("@timestamp", span.StartTimestamp()) // We use @timestamp in order to ensure that we can index if the default data stream logs template is used.
 	document.AddTimestamp("EndTimestamp", span.EndTimestamp())
@@ -73,7 +150,69 @@ func (m *encodeModel) encodeSpan(resource pcommon.Resource, span ptrace.Span) ([
 	document.AddString("Link", spanLinksToString(span.Links()))
 	document.AddAttributes("Attributes", span.Attributes())
 	document.AddAttributes("Resource", resource.Attributes())
+	return document
+}
+
+// encodeSpanOTelMode keeps the OpenTelemetry data model, with the attributes of the span,
+// of its scope and of its resource in separate objects.
+func encodeSpanOTelMode(resource pcommon.Resource, scope pcommon.InstrumentationScope, span ptrace.Span) objmodel.Document {
+	var document objmodel.Document
+	document.AddTimestamp("@timestamp", span.StartTimestamp())
+	document.AddInt("duration", int64(span.EndTimestamp()-span.StartTimestamp()))
+	document.AddTraceID("trace_id", span.TraceID())
+	document.AddSpanID("span_id", span.SpanID())
+	document.AddSpanID("parent_span_id", span.ParentSpanID())
+	document.AddString("trace_state", span.TraceState().AsRaw())
+	document.AddString("name", span.Name())
+	document.AddString("kind", span.Kind().String())
+	document.AddString("status.code", span.Status().Code().String())
+	document.AddString("status.message", span.Status().Message())
+	document.AddAttributes("attributes", span.Attributes())
+	if span.Links().Len() > 0 {
+		links := pcommon.NewValueSlice()
+		for i := 0; i < span.Links().Len(); i++ {
+			spanLink := span.Links().At(i)
+			link := links.Slice().AppendEmpty().SetEmptyMap()
+			link.PutStr("trace_id", traceutil.TraceIDToHexOrEmptyString(spanLink.TraceID()))
+			link.PutStr("span_id", traceutil.SpanIDToHexOrEmptyString(spanLink.SpanID()))
+			spanLink.Attributes().CopyTo(link.PutEmptyMap("attributes"))
+		}
+		document.Add("links", objmodel.ValueFromAttribute(links))
+	}
+	addOTelScopeAndResource(&document, resource, scope)
+	return document
+}
+
+func addOTelScopeAndResource(document *objmodel.Document, resource pcommon.Resource, scope pcommon.InstrumentationScope) {
+	document.AddString("scope.name", scope.Name())
+	document.AddString("scope.version", scope.Version())
+	document.AddAttributes("scope.attributes", scope.Attributes())
+	document.AddAttributes("resource.attributes", resource.Attributes())
+}
+
+// encodeDataPoints encodes the values of the data points as fields named after their metrics,
+// along with the attributes shared by the data points.
+func (m *encodeModel) encodeDataPoints(resource pcommon.Resource, group *dataPointGroup) ([]byte, error) {
+	var document objmodel.Document
+	document.AddTimestamp("@timestamp", group.timestamp) // We use @timestamp in order to ensure that we can index if the default data stream metrics template is used.
+	for _, value := range group.values {
+		document.AddAttribute(value.name, value.value)
+	}
+	switch m.mode {
+	case MappingECS:
+		document.AddAttributes("", group.attributes)
+		addECSResource(&document, resource)
+	case MappingOTel:
+		document.AddAttributes("attributes", group.attributes)
+		document.AddAttributes("resource.attributes", resource.Attributes())
+	default:
+		document.AddAttributes("Attributes", group.attributes)
+		document.AddAttributes("Resource", resource.Attributes())
+	}
+	return m.serialize(document)
+}
 
+func (m *encodeModel) serialize(document objmodel.Document) ([]byte, error) {
 	if m.dedup {
 		document.Dedup()
 	} else if m.dedot {
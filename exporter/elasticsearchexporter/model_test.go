@@ -0,0 +1,252 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var (
+	testModelTimestamp = pcommon.NewTimestampFromTime(time.Date(2023, 5, 4, 10, 30, 0, 0, time.UTC))
+	testModelTraceID   = pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	testModelSpanID    = pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	testModelParentID  = pcommon.SpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1})
+)
+
+func newTestModelResource() pcommon.Resource {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.name", "checkout")
+	resource.Attributes().PutStr("service.instance.id", "checkout-1")
+	resource.Attributes().PutStr("host.name", "node-1")
+	resource.Attributes().PutStr("k8s.namespace.name", "shop")
+	resource.Attributes().PutStr("k8s.pod.name", "checkout-6b8f9")
+	resource.Attributes().PutStr("k8s.pod.uid", "a2b3")
+	return resource
+}
+
+func newTestModelScope() pcommon.InstrumentationScope {
+	scope := pcommon.NewInstrumentationScope()
+	scope.SetName("checkout-lib")
+	scope.SetVersion("1.0.0")
+	scope.Attributes().PutStr("lib.feature", "orders")
+	return scope
+}
+
+func newTestModelLogRecord() plog.LogRecord {
+	record := plog.NewLogRecord()
+	record.SetObservedTimestamp(testModelTimestamp)
+	record.SetTraceID(testModelTraceID)
+	record.SetSpanID(testModelSpanID)
+	record.SetSeverityText("ERROR")
+	record.SetSeverityNumber(plog.SeverityNumberError)
+	record.Body().SetStr("payment failed")
+	record.Attributes().PutStr("exception.type", "PaymentError")
+	record.Attributes().PutStr("order.id", "42")
+	return record
+}
+
+func newTestModelSpan() ptrace.Span {
+	span := ptrace.NewSpan()
+	span.SetStartTimestamp(testModelTimestamp)
+	span.SetEndTimestamp(testModelTimestamp + 1500)
+	span.SetTraceID(testModelTraceID)
+	span.SetSpanID(testModelSpanID)
+	span.SetParentSpanID(testModelParentID)
+	span.SetName("GET /cart")
+	span.SetKind(ptrace.SpanKindServer)
+	span.Status().SetCode(ptrace.StatusCodeError)
+	span.Attributes().PutStr("http.method", "GET")
+	span.Attributes().PutInt("http.status_code", 500)
+	span.Attributes().PutStr("cart.size", "3")
+	return span
+}
+
+func decodeDocument(t *testing.T, document []byte) map[string]interface{} {
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(document, &decoded))
+	return decoded
+}
+
+func TestEncodeLog(t *testing.T) {
+	tests := []struct {
+		mode     MappingMode
+		expected map[string]interface{}
+	}{
+		{
+			mode: MappingECS,
+			expected: map[string]interface{}{
+				"@timestamp":                 "2023-05-04T10:30:00.000000000Z",
+				"trace.id":                   "0102030405060708090a0b0c0d0e0f10",
+				"span.id":                    "0102030405060708",
+				"log.level":                  "ERROR",
+				"event.severity":             17.0,
+				"message":                    "payment failed",
+				"error.type":                 "PaymentError",
+				"order.id":                   "42",
+				"service.name":               "checkout",
+				"service.node.name":          "checkout-1",
+				"host.hostname":              "node-1",
+				"host.name":                  "node-1",
+				"orchestrator.namespace":     "shop",
+				"orchestrator.resource.name": "checkout-6b8f9",
+				"orchestrator.resource.id":   "a2b3",
+				"orchestrator.resource.type": "pod",
+				"orchestrator.type":          "kubernetes",
+			},
+		},
+		{
+			mode: MappingOTel,
+			expected: map[string]interface{}{
+				"@timestamp":                       "2023-05-04T10:30:00.000000000Z",
+				"observed_timestamp":               "2023-05-04T10:30:00.000000000Z",
+				"trace_id":                         "0102030405060708090a0b0c0d0e0f10",
+				"span_id":                          "0102030405060708",
+				"flags":                            0.0,
+				"severity_text":                    "ERROR",
+				"severity_number":                  17.0,
+				"body":                             "payment failed",
+				"attributes.exception.type":        "PaymentError",
+				"attributes.order.id":              "42",
+				"scope.name":                       "checkout-lib",
+				"scope.version":                    "1.0.0",
+				"scope.attributes.lib.feature":     "orders",
+				"resource.attributes.service.name": "checkout",
+				"resource.attributes.service.instance.id": "checkout-1",
+				"resource.attributes.host.name":           "node-1",
+				"resource.attributes.k8s.namespace.name":  "shop",
+				"resource.attributes.k8s.pod.name":        "checkout-6b8f9",
+				"resource.attributes.k8s.pod.uid":         "a2b3",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			model := &encodeModel{dedup: true, dedot: false, mode: tt.mode}
+			document, err := model.encodeLog(newTestModelResource(), newTestModelScope(), newTestModelLogRecord())
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, decodeDocument(t, document))
+		})
+	}
+}
+
+func TestEncodeSpan(t *testing.T) {
+	tests := []struct {
+		mode     MappingMode
+		expected map[string]interface{}
+	}{
+		{
+			mode: MappingECS,
+			expected: map[string]interface{}{
+				"@timestamp":                 "2023-05-04T10:30:00.000000000Z",
+				"trace.id":                   "0102030405060708090a0b0c0d0e0f10",
+				"span.id":                    "0102030405060708",
+				"parent.id":                  "0807060504030201",
+				"span.name":                  "GET /cart",
+				"span.kind":                  "Server",
+				"event.duration":             1500.0,
+				"event.outcome":              "failure",
+				"http.request.method":        "GET",
+				"http.response.status_code":  500.0,
+				"cart.size":                  "3",
+				"service.name":               "checkout",
+				"service.node.name":          "checkout-1",
+				"host.hostname":              "node-1",
+				"host.name":                  "node-1",
+				"orchestrator.namespace":     "shop",
+				"orchestrator.resource.name": "checkout-6b8f9",
+				"orchestrator.resource.id":   "a2b3",
+				"orchestrator.resource.type": "pod",
+				"orchestrator.type":          "kubernetes",
+			},
+		},
+		{
+			mode: MappingOTel,
+			expected: map[string]interface{}{
+				"@timestamp":                       "2023-05-04T10:30:00.000000000Z",
+				"duration":                         1500.0,
+				"trace_id":                         "0102030405060708090a0b0c0d0e0f10",
+				"span_id":                          "0102030405060708",
+				"parent_span_id":                   "0807060504030201",
+				"name":                             "GET /cart",
+				"kind":                             "Server",
+				"status.code":                      "Error",
+				"attributes.http.method":           "GET",
+				"attributes.http.status_code":      500.0,
+				"attributes.cart.size":             "3",
+				"scope.name":                       "checkout-lib",
+				"scope.version":                    "1.0.0",
+				"scope.attributes.lib.feature":     "orders",
+				"resource.attributes.service.name": "checkout",
+				"resource.attributes.service.instance.id": "checkout-1",
+				"resource.attributes.host.name":           "node-1",
+				"resource.attributes.k8s.namespace.name":  "shop",
+				"resource.attributes.k8s.pod.name":        "checkout-6b8f9",
+				"resource.attributes.k8s.pod.uid":         "a2b3",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			model := &encodeModel{dedup: true, dedot: false, mode: tt.mode}
+			document, err := model.encodeSpan(newTestModelResource(), newTestModelScope(), newTestModelSpan())
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, decodeDocument(t, document))
+		})
+	}
+}
+
+func TestEncodeSpanOTelLinks(t *testing.T) {
+	span := newTestModelSpan()
+	link := span.Links().AppendEmpty()
+	link.SetTraceID(testModelTraceID)
+	link.SetSpanID(testModelParentID)
+	link.Attributes().PutStr("link.kind", "follows")
+
+	model := &encodeModel{dedup: true, dedot: true, mode: MappingOTel}
+	document, err := model.encodeSpan(pcommon.NewResource(), pcommon.NewInstrumentationScope(), span)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"trace_id":   "0102030405060708090a0b0c0d0e0f10",
+			"span_id":    "0807060504030201",
+			"attributes": map[string]interface{}{"link": map[string]interface{}{"kind": "follows"}},
+		},
+	}, decodeDocument(t, document)["links"])
+}
+
+func TestModelForRequest(t *testing.T) {
+	model := &encodeModel{dedup: true, dedot: true, mode: MappingECS}
+
+	requestModel, err := modelForRequest(context.Background(), model)
+	require.NoError(t, err)
+	assert.Same(t, model, requestModel)
+
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{mappingModeMetadataKey: {"otel"}}),
+	})
+	requestModel, err = modelForRequest(ctx, model)
+	require.NoError(t, err)
+	assert.Equal(t, &encodeModel{dedup: true, dedot: true, mode: MappingOTel}, requestModel)
+	assert.Equal(t, MappingECS, model.mode)
+
+	ctx = client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{mappingModeMetadataKey: {"unknown"}}),
+	})
+	_, err = modelForRequest(ctx, model)
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+}
@@ -25,6 +25,14 @@ type Config struct {
 	// The name of the kafka topic to export to (default otlp_spans for traces, otlp_metrics for metrics)
 	Topic string `mapstructure:"topic"`
 
+	// TopicFromAttribute is the name of the resource attribute holding the topic to export
+	// the data of the resource to. The data is exported to Topic when the attribute is missing.
+	TopicFromAttribute string `mapstructure:"topic_from_attribute"`
+
+	// PartitionKeyFromAttribute is the name of the resource attribute holding the key of
+	// the messages, that decides on which partition the data of the resource is exported.
+	PartitionKeyFromAttribute string `mapstructure:"partition_key_from_attribute"`
+
 	// Encoding of messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
 
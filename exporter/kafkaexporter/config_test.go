@@ -70,6 +70,15 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(typeStr, "routing"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.TopicFromAttribute = "tenant"
+				cfg.PartitionKeyFromAttribute = "service.name"
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
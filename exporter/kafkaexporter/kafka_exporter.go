@@ -22,7 +22,7 @@ var errUnrecognizedEncoding = fmt.Errorf("unrecognized encoding")
 // kafkaTracesProducer uses sarama to produce trace messages to Kafka.
 type kafkaTracesProducer struct {
 	producer  sarama.SyncProducer
-	topic     string
+	router    router
 	marshaler TracesMarshaler
 	logger    *zap.Logger
 }
@@ -37,11 +37,16 @@ func (ke kafkaErrors) Error() string {
 }
 
 func (e *kafkaTracesProducer) tracesPusher(_ context.Context, td ptrace.Traces) error {
-	messages, err := e.marshaler.Marshal(td, e.topic)
-	if err != nil {
-		return consumererror.NewPermanent(err)
+	var messages []*sarama.ProducerMessage
+	for dest, batch := range e.router.splitTraces(td) {
+		msgs, err := e.marshaler.Marshal(batch, dest.topic)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		dest.setKey(msgs)
+		messages = append(messages, msgs...)
 	}
-	err = e.producer.SendMessages(messages)
+	err := e.producer.SendMessages(messages)
 	if err != nil {
 		var prodErr sarama.ProducerErrors
 		if errors.As(err, &prodErr) {
@@ -61,17 +66,22 @@ func (e *kafkaTracesProducer) Close(context.Context) error {
 // kafkaMetricsProducer uses sarama to produce metrics messages to kafka
 type kafkaMetricsProducer struct {
 	producer  sarama.SyncProducer
-	topic     string
+	router    router
 	marshaler MetricsMarshaler
 	logger    *zap.Logger
 }
 
 func (e *kafkaMetricsProducer) metricsDataPusher(_ context.Context, md pmetric.Metrics) error {
-	messages, err := e.marshaler.Marshal(md, e.topic)
-	if err != nil {
-		return consumererror.NewPermanent(err)
+	var messages []*sarama.ProducerMessage
+	for dest, batch := range e.router.splitMetrics(md) {
+		msgs, err := e.marshaler.Marshal(batch, dest.topic)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		dest.setKey(msgs)
+		messages = append(messages, msgs...)
 	}
-	err = e.producer.SendMessages(messages)
+	err := e.producer.SendMessages(messages)
 	if err != nil {
 		var prodErr sarama.ProducerErrors
 		if errors.As(err, &prodErr) {
@@ -91,17 +101,22 @@ func (e *kafkaMetricsProducer) Close(context.Context) error {
 // kafkaLogsProducer uses sarama to produce logs messages to kafka
 type kafkaLogsProducer struct {
 	producer  sarama.SyncProducer
-	topic     string
+	router    router
 	marshaler LogsMarshaler
 	logger    *zap.Logger
 }
 
 func (e *kafkaLogsProducer) logsDataPusher(_ context.Context, ld plog.Logs) error {
-	messages, err := e.marshaler.Marshal(ld, e.topic)
-	if err != nil {
-		return consumererror.NewPermanent(err)
+	var messages []*sarama.ProducerMessage
+	for dest, batch := range e.router.splitLogs(ld) {
+		msgs, err := e.marshaler.Marshal(batch, dest.topic)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		dest.setKey(msgs)
+		messages = append(messages, msgs...)
 	}
-	err = e.producer.SendMessages(messages)
+	err := e.producer.SendMessages(messages)
 	if err != nil {
 		var prodErr sarama.ProducerErrors
 		if errors.As(err, &prodErr) {
@@ -169,7 +184,7 @@ func newMetricsExporter(config Config, set exporter.CreateSettings, marshalers m
 
 	return &kafkaMetricsProducer{
 		producer:  producer,
-		topic:     config.Topic,
+		router:    newRouter(config),
 		marshaler: marshaler,
 		logger:    set.Logger,
 	}, nil
@@ -188,7 +203,7 @@ func newTracesExporter(config Config, set exporter.CreateSettings, marshalers ma
 	}
 	return &kafkaTracesProducer{
 		producer:  producer,
-		topic:     config.Topic,
+		router:    newRouter(config),
 		marshaler: marshaler,
 		logger:    set.Logger,
 	}, nil
@@ -206,7 +221,7 @@ func newLogsExporter(config Config, set exporter.CreateSettings, marshalers map[
 
 	return &kafkaLogsProducer{
 		producer:  producer,
-		topic:     config.Topic,
+		router:    newRouter(config),
 		marshaler: marshaler,
 		logger:    set.Logger,
 	}, nil
@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+
+import (
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// destination is the topic and partition key the data of a resource is sent with.
+type destination struct {
+	topic string
+	key   string
+}
+
+// router chooses the destination of the data out of the resource attributes.
+type router struct {
+	topic                 string
+	topicAttribute        string
+	partitionKeyAttribute string
+}
+
+func newRouter(config Config) router {
+	return router{
+		topic:                 config.Topic,
+		topicAttribute:        config.TopicFromAttribute,
+		partitionKeyAttribute: config.PartitionKeyFromAttribute,
+	}
+}
+
+func (r router) enabled() bool {
+	return r.topicAttribute != "" || r.partitionKeyAttribute != ""
+}
+
+func (r router) destination(res pcommon.Resource) destination {
+	dest := destination{topic: r.topic}
+	if r.topicAttribute != "" {
+		if v, ok := res.Attributes().Get(r.topicAttribute); ok && v.AsString() != "" {
+			dest.topic = v.AsString()
+		}
+	}
+	if r.partitionKeyAttribute != "" {
+		if v, ok := res.Attributes().Get(r.partitionKeyAttribute); ok {
+			dest.key = v.AsString()
+		}
+	}
+	return dest
+}
+
+// setKey sets the partition key of the messages, when the destination has one.
+func (d destination) setKey(messages []*sarama.ProducerMessage) {
+	if d.key == "" {
+		return
+	}
+	for _, m := range messages {
+		m.Key = sarama.StringEncoder(d.key)
+	}
+}
+
+func (r router) splitTraces(td ptrace.Traces) map[destination]ptrace.Traces {
+	if !r.enabled() {
+		return map[destination]ptrace.Traces{{topic: r.topic}: td}
+	}
+	batches := make(map[destination]ptrace.Traces)
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		dest := r.destination(rs.Resource())
+		batch, ok := batches[dest]
+		if !ok {
+			batch = ptrace.NewTraces()
+			batches[dest] = batch
+		}
+		rs.CopyTo(batch.ResourceSpans().AppendEmpty())
+	}
+	return batches
+}
+
+func (r router) splitMetrics(md pmetric.Metrics) map[destination]pmetric.Metrics {
+	if !r.enabled() {
+		return map[destination]pmetric.Metrics{{topic: r.topic}: md}
+	}
+	batches := make(map[destination]pmetric.Metrics)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		dest := r.destination(rm.Resource())
+		batch, ok := batches[dest]
+		if !ok {
+			batch = pmetric.NewMetrics()
+			batches[dest] = batch
+		}
+		rm.CopyTo(batch.ResourceMetrics().AppendEmpty())
+	}
+	return batches
+}
+
+func (r router) splitLogs(ld plog.Logs) map[destination]plog.Logs {
+	if !r.enabled() {
+		return map[destination]plog.Logs{{topic: r.topic}: ld}
+	}
+	batches := make(map[destination]plog.Logs)
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		dest := r.destination(rl.Resource())
+		batch, ok := batches[dest]
+		if !ok {
+			batch = plog.NewLogs()
+			batches[dest] = batch
+		}
+		rl.CopyTo(batch.ResourceLogs().AppendEmpty())
+	}
+	return batches
+}
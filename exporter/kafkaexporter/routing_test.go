@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkaexporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func tenantTraces(tenants ...string) ptrace.Traces {
+	td := ptrace.NewTraces()
+	for _, tenant := range tenants {
+		rs := td.ResourceSpans().AppendEmpty()
+		if tenant != "" {
+			rs.Resource().Attributes().PutStr("tenant", tenant)
+		}
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	}
+	return td
+}
+
+func TestRouterDisabled(t *testing.T) {
+	r := newRouter(Config{Topic: "spans"})
+	td := tenantTraces("a", "b")
+	batches := r.splitTraces(td)
+	require.Len(t, batches, 1)
+	assert.Equal(t, td, batches[destination{topic: "spans"}])
+}
+
+func TestRouterSplitTraces(t *testing.T) {
+	r := newRouter(Config{Topic: "spans", TopicFromAttribute: "tenant", PartitionKeyFromAttribute: "tenant"})
+	batches := r.splitTraces(tenantTraces("a", "b", "a", ""))
+	require.Len(t, batches, 3)
+	assert.Equal(t, 2, batches[destination{topic: "a", key: "a"}].ResourceSpans().Len())
+	assert.Equal(t, 1, batches[destination{topic: "b", key: "b"}].ResourceSpans().Len())
+	assert.Equal(t, 1, batches[destination{topic: "spans"}].ResourceSpans().Len())
+}
+
+func TestRouterSplitMetricsAndLogs(t *testing.T) {
+	r := newRouter(Config{Topic: "default", TopicFromAttribute: "tenant"})
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().Resource().Attributes().PutStr("tenant", "a")
+	md.ResourceMetrics().AppendEmpty().Resource().Attributes().PutStr("tenant", "b")
+	metricBatches := r.splitMetrics(md)
+	assert.Len(t, metricBatches, 2)
+	assert.Contains(t, metricBatches, destination{topic: "a"})
+	assert.Contains(t, metricBatches, destination{topic: "b"})
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("tenant", "a")
+	ld.ResourceLogs().AppendEmpty()
+	logBatches := r.splitLogs(ld)
+	assert.Len(t, logBatches, 2)
+	assert.Contains(t, logBatches, destination{topic: "a"})
+	assert.Contains(t, logBatches, destination{topic: "default"})
+}
+
+func TestTracesPusherRouting(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, sarama.NewConfig())
+	// the batches are sent in no particular order, but each one goes to the topic of its
+	// tenant, with the tenant as key
+	checker := func(msg *sarama.ProducerMessage) error {
+		assert.Contains(t, []string{"a", "b"}, msg.Topic)
+		key, err := msg.Key.Encode()
+		require.NoError(t, err)
+		assert.Equal(t, msg.Topic, string(key))
+		return nil
+	}
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(checker)
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(checker)
+
+	p := kafkaTracesProducer{
+		producer:  producer,
+		router:    newRouter(Config{Topic: "spans", TopicFromAttribute: "tenant", PartitionKeyFromAttribute: "tenant"}),
+		marshaler: newPdataTracesMarshaler(&ptrace.ProtoMarshaler{}, defaultEncoding),
+		logger:    zap.NewNop(),
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+	require.NoError(t, p.tracesPusher(context.Background(), tenantTraces("a", "b")))
+}
@@ -14,6 +14,8 @@ type routingKey int
 const (
 	traceIDRouting routingKey = iota
 	svcRouting
+	attrRouting
+	metricRouting
 )
 
 // Config defines configuration for the exporter.
@@ -21,6 +23,8 @@ type Config struct {
 	Protocol   Protocol         `mapstructure:"protocol"`
 	Resolver   ResolverSettings `mapstructure:"resolver"`
 	RoutingKey string           `mapstructure:"routing_key"`
+	// RoutingAttributes are the attributes whose values make up the routing key when RoutingKey is "attributes"
+	RoutingAttributes []string `mapstructure:"routing_attributes"`
 }
 
 // Protocol holds the individual protocol-specific settings. Only OTLP is supported at the moment.
@@ -32,6 +36,7 @@ type Protocol struct {
 type ResolverSettings struct {
 	Static *StaticResolver `mapstructure:"static"`
 	DNS    *DNSResolver    `mapstructure:"dns"`
+	K8sSvc *K8sSvcResolver `mapstructure:"k8s"`
 }
 
 // StaticResolver defines the configuration for the resolver providing a fixed list of backends
@@ -46,3 +51,11 @@ type DNSResolver struct {
 	Interval time.Duration `mapstructure:"interval"`
 	Timeout  time.Duration `mapstructure:"timeout"`
 }
+
+// K8sSvcResolver defines the configuration for the resolver watching the endpoints of a Kubernetes service
+type K8sSvcResolver struct {
+	// Service is the name of the service, optionally followed by its namespace, as in "name.namespace"
+	Service string `mapstructure:"service"`
+	// Ports is the list of ports used to reach the backends on each pod
+	Ports []int32 `mapstructure:"ports"`
+}
@@ -46,3 +46,21 @@ func TestLogExporterGetsCreatedWithValidConfiguration(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, exp)
 }
+
+func TestMetricsExporterGetsCreatedWithValidConfiguration(t *testing.T) {
+	// prepare
+	factory := NewFactory()
+	creationParams := exportertest.NewNopCreateSettings()
+	cfg := &Config{
+		Resolver: ResolverSettings{
+			Static: &StaticResolver{Hostnames: []string{"endpoint-1"}},
+		},
+	}
+
+	// test
+	exp, err := factory.CreateMetricsExporter(context.Background(), creationParams, cfg)
+
+	// verify
+	assert.Nil(t, err)
+	assert.NotNil(t, exp)
+}
@@ -13,6 +13,9 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter"
 	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
 const (
@@ -26,6 +29,11 @@ var (
 
 var _ loadBalancer = (*loadBalancerImp)(nil)
 
+// newK8sClient creates the client used by the Kubernetes resolver; it's a variable so that tests can replace it
+var newK8sClient = func() (kubernetes.Interface, error) {
+	return k8sconfig.MakeClient(k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount})
+}
+
 type componentFactory func(ctx context.Context, endpoint string) (component.Component, error)
 
 type loadBalancer interface {
@@ -52,7 +60,7 @@ type loadBalancerImp struct {
 func newLoadBalancer(params exporter.CreateSettings, cfg component.Config, factory componentFactory) (*loadBalancerImp, error) {
 	oCfg := cfg.(*Config)
 
-	if oCfg.Resolver.DNS != nil && oCfg.Resolver.Static != nil {
+	if countResolvers(oCfg.Resolver) > 1 {
 		return nil, errMultipleResolversProvided
 	}
 
@@ -74,6 +82,19 @@ func newLoadBalancer(params exporter.CreateSettings, cfg component.Config, facto
 		}
 	}
 
+	if oCfg.Resolver.K8sSvc != nil {
+		k8sLogger := params.Logger.With(zap.String("resolver", "k8s service"))
+
+		clt, err := newK8sClient()
+		if err != nil {
+			return nil, err
+		}
+		res, err = newK8sResolver(clt, k8sLogger, oCfg.Resolver.K8sSvc.Service, oCfg.Resolver.K8sSvc.Ports)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if res == nil {
 		return nil, errNoResolver
 	}
@@ -86,6 +107,21 @@ func newLoadBalancer(params exporter.CreateSettings, cfg component.Config, facto
 	}, nil
 }
 
+// countResolvers returns how many resolvers are configured.
+func countResolvers(cfg ResolverSettings) int {
+	count := 0
+	if cfg.Static != nil {
+		count++
+	}
+	if cfg.DNS != nil {
+		count++
+	}
+	if cfg.K8sSvc != nil {
+		count++
+	}
+	return count
+}
+
 func (lb *loadBalancerImp) Start(ctx context.Context, host component.Host) error {
 	lb.res.onChange(lb.onBackendChanges)
 	lb.host = host
@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+var _ exporter.Metrics = (*metricExporterImp)(nil)
+
+type metricExporterImp struct {
+	loadBalancer      loadBalancer
+	routingKey        routingKey
+	routingAttributes []string
+
+	stopped    bool
+	shutdownWg sync.WaitGroup
+}
+
+// Create new metrics exporter
+func newMetricsExporter(params exporter.CreateSettings, cfg component.Config) (*metricExporterImp, error) {
+	exporterFactory := otlpexporter.NewFactory()
+
+	lb, err := newLoadBalancer(params, cfg, func(ctx context.Context, endpoint string) (component.Component, error) {
+		oCfg := buildExporterConfig(cfg.(*Config), endpoint)
+		return exporterFactory.CreateMetricsExporter(ctx, params, &oCfg)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metricExporter := metricExporterImp{loadBalancer: lb, routingKey: metricRouting}
+
+	switch cfg.(*Config).RoutingKey {
+	case "service":
+		metricExporter.routingKey = svcRouting
+	case "attributes":
+		if len(cfg.(*Config).RoutingAttributes) == 0 {
+			return nil, errNoRoutingAttributes
+		}
+		metricExporter.routingKey = attrRouting
+		metricExporter.routingAttributes = cfg.(*Config).RoutingAttributes
+	case "metric", "":
+	default:
+		return nil, fmt.Errorf("unsupported routing_key for metrics: %s", cfg.(*Config).RoutingKey)
+	}
+	return &metricExporter, nil
+}
+
+func (e *metricExporterImp) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (e *metricExporterImp) Start(ctx context.Context, host component.Host) error {
+	return e.loadBalancer.Start(ctx, host)
+}
+
+func (e *metricExporterImp) Shutdown(context.Context) error {
+	e.stopped = true
+	e.shutdownWg.Wait()
+	return nil
+}
+
+func (e *metricExporterImp) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	var errs error
+	for rid, batch := range splitMetrics(md, e.routingKey, e.routingAttributes) {
+		errs = multierr.Append(errs, e.consumeMetric(ctx, batch, rid))
+	}
+	return errs
+}
+
+func (e *metricExporterImp) consumeMetric(ctx context.Context, md pmetric.Metrics, rid string) error {
+	endpoint := e.loadBalancer.Endpoint([]byte(rid))
+	exp, err := e.loadBalancer.Exporter(endpoint)
+	if err != nil {
+		return err
+	}
+
+	me, ok := exp.(exporter.Metrics)
+	if !ok {
+		return fmt.Errorf("unable to export metrics, unexpected exporter type: expected exporter.Metrics but got %T", exp)
+	}
+
+	start := time.Now()
+	err = me.ConsumeMetrics(ctx, md)
+	duration := time.Since(start)
+
+	if err == nil {
+		_ = stats.RecordWithTags(
+			ctx,
+			[]tag.Mutator{tag.Upsert(endpointTagKey, endpoint), successTrueMutator},
+			mBackendLatency.M(duration.Milliseconds()))
+	} else {
+		_ = stats.RecordWithTags(
+			ctx,
+			[]tag.Mutator{tag.Upsert(endpointTagKey, endpoint), successFalseMutator},
+			mBackendLatency.M(duration.Milliseconds()))
+	}
+	return err
+}
+
+// resourceRoutingKey returns the part of the routing key coming from the resource.
+func resourceRoutingKey(res pcommon.Resource, key routingKey, attrs []string) string {
+	switch key {
+	case svcRouting:
+		if svc, ok := res.Attributes().Get(conventions.AttributeServiceName); ok {
+			return svc.Str()
+		}
+		return ""
+	case attrRouting:
+		return attributesRoutingKey(attrs, res.Attributes())
+	default:
+		// the identity of the resource is the set of all its attributes
+		hash := pdatautil.MapHash(res.Attributes())
+		return string(hash[:])
+	}
+}
+
+// splitMetrics groups the metrics by their routing key. When routing by metric, the
+// routing key is made of the resource identity and the metric name, so that all the
+// data points of a metric stream go to the same backend.
+func splitMetrics(md pmetric.Metrics, key routingKey, attrs []string) map[string]pmetric.Metrics {
+	batches := make(map[string]pmetric.Metrics)
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		resKey := resourceRoutingKey(rm.Resource(), key, attrs)
+		rmByKey := make(map[string]pmetric.ResourceMetrics)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			smByKey := make(map[string]pmetric.ScopeMetrics)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				metric := sm.Metrics().At(k)
+				rid := resKey
+				if key == metricRouting {
+					rid += metric.Name()
+				}
+
+				dest, ok := smByKey[rid]
+				if !ok {
+					destRM, found := rmByKey[rid]
+					if !found {
+						batch, exists := batches[rid]
+						if !exists {
+							batch = pmetric.NewMetrics()
+							batches[rid] = batch
+						}
+						destRM = batch.ResourceMetrics().AppendEmpty()
+						rm.Resource().CopyTo(destRM.Resource())
+						destRM.SetSchemaUrl(rm.SchemaUrl())
+						rmByKey[rid] = destRM
+					}
+					dest = destRM.ScopeMetrics().AppendEmpty()
+					sm.Scope().CopyTo(dest.Scope())
+					dest.SetSchemaUrl(sm.SchemaUrl())
+					smByKey[rid] = dest
+				}
+				metric.CopyTo(dest.Metrics().AppendEmpty())
+			}
+		}
+	}
+	return batches
+}
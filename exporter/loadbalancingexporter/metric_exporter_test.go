@@ -0,0 +1,231 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
+)
+
+func TestNewMetricsExporter(t *testing.T) {
+	for _, tt := range []struct {
+		desc   string
+		config *Config
+		err    error
+	}{
+		{
+			"simple",
+			simpleConfig(),
+			nil,
+		},
+		{
+			"empty",
+			&Config{},
+			errNoResolver,
+		},
+		{
+			"attributes without routing attributes",
+			&Config{
+				Resolver:   simpleConfig().Resolver,
+				RoutingKey: "attributes",
+			},
+			errNoRoutingAttributes,
+		},
+		{
+			"trace ID",
+			&Config{
+				Resolver:   simpleConfig().Resolver,
+				RoutingKey: "traceID",
+			},
+			errors.New("unsupported routing_key for metrics: traceID"),
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// test
+			_, err := newMetricsExporter(exportertest.NewNopCreateSettings(), tt.config)
+
+			// verify
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestConsumeMetrics(t *testing.T) {
+	sinks := map[string]*consumertest.MetricsSink{
+		"endpoint-1:4317": new(consumertest.MetricsSink),
+		"endpoint-2:4317": new(consumertest.MetricsSink),
+	}
+	componentFactory := func(ctx context.Context, endpoint string) (component.Component, error) {
+		return newMockMetricsExporter(sinks[endpoint].ConsumeMetrics), nil
+	}
+	cfg := &Config{
+		Resolver: ResolverSettings{
+			Static: &StaticResolver{Hostnames: []string{"endpoint-1", "endpoint-2"}},
+		},
+	}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newMetricsExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+	assert.Equal(t, metricRouting, p.routingKey)
+
+	p.loadBalancer = lb
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	md := metricsWithNames("svc-1", "metric-1", "metric-2", "metric-3", "metric-4")
+	require.NoError(t, p.ConsumeMetrics(context.Background(), md))
+	require.NoError(t, p.ConsumeMetrics(context.Background(), md))
+
+	// verify: every metric was exported twice, both times to the same backend
+	total := 0
+	for _, sink := range sinks {
+		total += sink.DataPointCount()
+		names := map[string]int{}
+		for _, received := range sink.AllMetrics() {
+			ms := received.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < ms.Len(); i++ {
+				names[ms.At(i).Name()]++
+			}
+		}
+		for name, count := range names {
+			assert.Equal(t, 2, count, "metric %s was split among backends", name)
+		}
+	}
+	assert.Equal(t, 8, total)
+}
+
+func TestConsumeMetricsUnexpectedExporterType(t *testing.T) {
+	componentFactory := func(ctx context.Context, endpoint string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), simpleConfig(), componentFactory)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newMetricsExporter(exportertest.NewNopCreateSettings(), simpleConfig())
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// pre-load an exporter here, so that we don't use the actual OTLP exporter
+	lb.addMissingExporters(context.Background(), []string{"endpoint-1"})
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(ctx context.Context) ([]string, error) {
+			return []string{"endpoint-1"}, nil
+		},
+	}
+	p.loadBalancer = lb
+
+	err = p.Start(context.Background(), componenttest.NewNopHost())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// test
+	res := p.ConsumeMetrics(context.Background(), metricsWithNames("svc-1", "metric-1"))
+
+	// verify
+	assert.Error(t, res)
+	assert.EqualError(t, res, fmt.Sprintf("unable to export metrics, unexpected exporter type: expected exporter.Metrics but got %T", newNopMockExporter()))
+}
+
+func TestSplitMetrics(t *testing.T) {
+	md := metricsWithNames("svc-1", "metric-1", "metric-2")
+	metricsWithNames("svc-2", "metric-1").ResourceMetrics().At(0).CopyTo(md.ResourceMetrics().AppendEmpty())
+	md.ResourceMetrics().At(0).Resource().Attributes().PutStr("tenant.id", "acme")
+	md.ResourceMetrics().At(1).Resource().Attributes().PutStr("tenant.id", "acme")
+
+	for _, tt := range []struct {
+		desc          string
+		routingKey    routingKey
+		attrs         []string
+		expectedSizes []int
+	}{
+		{
+			desc:          "metric",
+			routingKey:    metricRouting,
+			expectedSizes: []int{1, 1, 1},
+		},
+		{
+			desc:          "service",
+			routingKey:    svcRouting,
+			expectedSizes: []int{1, 2},
+		},
+		{
+			desc:          "attributes",
+			routingKey:    attrRouting,
+			attrs:         []string{"tenant.id"},
+			expectedSizes: []int{3},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			// test
+			batches := splitMetrics(md, tt.routingKey, tt.attrs)
+
+			// verify
+			var sizes []int
+			for _, batch := range batches {
+				sizes = append(sizes, batch.MetricCount())
+			}
+			assert.ElementsMatch(t, tt.expectedSizes, sizes)
+		})
+	}
+}
+
+func metricsWithNames(service string, names ...string) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr(conventions.AttributeServiceName, service)
+	sm := rm.ScopeMetrics().AppendEmpty()
+	for _, name := range names {
+		m := sm.Metrics().AppendEmpty()
+		m.SetName(name)
+		m.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+	}
+	return md
+}
+
+type mockMetricsExporter struct {
+	component.Component
+	consumeMetricsFn func(ctx context.Context, md pmetric.Metrics) error
+}
+
+func newMockMetricsExporter(consumeMetricsFn func(ctx context.Context, md pmetric.Metrics) error) exporter.Metrics {
+	return &mockMetricsExporter{
+		Component:        mockComponent{},
+		consumeMetricsFn: consumeMetricsFn,
+	}
+}
+
+func (e *mockMetricsExporter) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (e *mockMetricsExporter) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if e.consumeMetricsFn == nil {
+		return nil
+	}
+	return e.consumeMetricsFn(ctx, md)
+}
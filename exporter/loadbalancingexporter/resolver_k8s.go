@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ resolver = (*k8sResolver)(nil)
+
+const (
+	defaultK8sNamespace   = "default"
+	defaultK8sSyncTimeout = 10 * time.Second
+)
+
+var (
+	errNoSvc = errors.New("no service specified to resolve the backends")
+
+	k8sResolverMutator = tag.Upsert(tag.MustNewKey("resolver"), "k8s")
+
+	k8sResolverSuccessTrueMutators  = []tag.Mutator{k8sResolverMutator, successTrueMutator}
+	k8sResolverSuccessFalseMutators = []tag.Mutator{k8sResolverMutator, successFalseMutator}
+)
+
+// k8sResolver watches the Endpoints of a Kubernetes service, and uses the
+// addresses of its ready pods as the backends.
+type k8sResolver struct {
+	logger *zap.Logger
+
+	svcName     string
+	svcNs       string
+	ports       []int32
+	syncTimeout time.Duration
+
+	listWatcher cache.ListerWatcher
+	informer    cache.SharedInformer
+
+	endpoints         []string
+	onChangeCallbacks []func([]string)
+
+	stopCh             chan struct{}
+	updateLock         sync.RWMutex
+	shutdownWg         sync.WaitGroup
+	changeCallbackLock sync.RWMutex
+}
+
+func newK8sResolver(clt kubernetes.Interface, logger *zap.Logger, service string, ports []int32) (*k8sResolver, error) {
+	if len(service) == 0 {
+		return nil, errNoSvc
+	}
+
+	// the service can be given as "name" or as "name.namespace"
+	nAddr := strings.SplitN(service, ".", 2)
+	name, namespace := nAddr[0], defaultK8sNamespace
+	if len(nAddr) > 1 {
+		namespace = nAddr[1]
+	}
+
+	if len(ports) == 0 {
+		port, _ := strconv.ParseInt(defaultPort, 10, 32)
+		ports = []int32{int32(port)}
+	}
+
+	selector := fields.OneTermEqualSelector("metadata.name", name).String()
+	listWatcher := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = selector
+			return clt.CoreV1().Endpoints(namespace).List(context.Background(), options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = selector
+			return clt.CoreV1().Endpoints(namespace).Watch(context.Background(), options)
+		},
+	}
+
+	return &k8sResolver{
+		logger:      logger,
+		svcName:     name,
+		svcNs:       namespace,
+		ports:       ports,
+		syncTimeout: defaultK8sSyncTimeout,
+		listWatcher: listWatcher,
+		stopCh:      make(chan struct{}),
+	}, nil
+}
+
+func (r *k8sResolver) start(ctx context.Context) error {
+	r.informer = cache.NewSharedInformer(r.listWatcher, &corev1.Endpoints{}, 0)
+	registration, err := r.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			r.onEndpoints(obj)
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			r.onEndpoints(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if eps, ok := obj.(*corev1.Endpoints); ok && eps.Name != r.svcName {
+				return
+			}
+			r.update(nil)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	r.shutdownWg.Add(1)
+	go func() {
+		defer r.shutdownWg.Done()
+		r.informer.Run(r.stopCh)
+	}()
+
+	syncCtx, cancel := context.WithTimeout(ctx, r.syncTimeout)
+	defer cancel()
+	// wait for the current endpoints to be handled, so that the backends are known once started
+	if !cache.WaitForCacheSync(syncCtx.Done(), registration.HasSynced) {
+		r.logger.Warn("timed out waiting for the endpoints of the service to be synced",
+			zap.String("service", r.svcName), zap.String("namespace", r.svcNs))
+		_ = stats.RecordWithTags(ctx, k8sResolverSuccessFalseMutators, mNumResolutions.M(1))
+	}
+
+	r.logger.Debug("Kubernetes resolver started",
+		zap.String("service", r.svcName), zap.String("namespace", r.svcNs), zap.Int32s("ports", r.ports))
+	return nil
+}
+
+func (r *k8sResolver) shutdown(ctx context.Context) error {
+	r.changeCallbackLock.Lock()
+	r.onChangeCallbacks = nil
+	r.changeCallbackLock.Unlock()
+
+	close(r.stopCh)
+	r.shutdownWg.Wait()
+	return nil
+}
+
+func (r *k8sResolver) resolve(ctx context.Context) ([]string, error) {
+	r.updateLock.RLock()
+	defer r.updateLock.RUnlock()
+	return r.endpoints, nil
+}
+
+func (r *k8sResolver) onChange(f func([]string)) {
+	r.changeCallbackLock.Lock()
+	defer r.changeCallbackLock.Unlock()
+	r.onChangeCallbacks = append(r.onChangeCallbacks, f)
+}
+
+// onEndpoints uses the ready addresses of the given Endpoints as the backends.
+func (r *k8sResolver) onEndpoints(obj interface{}) {
+	eps, ok := obj.(*corev1.Endpoints)
+	if !ok {
+		r.logger.Warn("received an unexpected object from the informer", zap.String("type", fmt.Sprintf("%T", obj)))
+		return
+	}
+
+	if eps.Name != r.svcName {
+		return
+	}
+
+	var backends []string
+	for _, subset := range eps.Subsets {
+		for _, addr := range subset.Addresses {
+			for _, port := range r.ports {
+				backends = append(backends, net.JoinHostPort(addr.IP, strconv.Itoa(int(port))))
+			}
+		}
+	}
+	r.update(backends)
+}
+
+// update replaces the list of backends and propagates it, unless it didn't change.
+func (r *k8sResolver) update(backends []string) {
+	ctx := context.Background()
+	_ = stats.RecordWithTags(ctx, k8sResolverSuccessTrueMutators, mNumResolutions.M(1))
+
+	// keep it always in the same order
+	sort.Strings(backends)
+
+	r.updateLock.Lock()
+	if equalStringSlice(r.endpoints, backends) {
+		r.updateLock.Unlock()
+		return
+	}
+
+	// the list has changed!
+	r.endpoints = backends
+	r.updateLock.Unlock()
+	_ = stats.RecordWithTags(ctx, k8sResolverSuccessTrueMutators, mNumBackends.M(int64(len(backends))))
+
+	// propagate the change
+	r.changeCallbackLock.RLock()
+	for _, callback := range r.onChangeCallbacks {
+		callback(backends)
+	}
+	r.changeCallbackLock.RUnlock()
+}
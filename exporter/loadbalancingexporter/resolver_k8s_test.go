@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newEndpoints(name, namespace string, ips ...string) *corev1.Endpoints {
+	addresses := make([]corev1.EndpointAddress, len(ips))
+	for i, ip := range ips {
+		addresses[i] = corev1.EndpointAddress{IP: ip}
+	}
+	return &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Subsets:    []corev1.EndpointSubset{{Addresses: addresses}},
+	}
+}
+
+// endpointsRecorder keeps the last list of backends received by a callback.
+type endpointsRecorder struct {
+	mutex    sync.Mutex
+	resolved []string
+}
+
+func (r *endpointsRecorder) onChange(endpoints []string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.resolved = endpoints
+}
+
+func (r *endpointsRecorder) get() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.resolved
+}
+
+func TestNewK8sResolverWithoutService(t *testing.T) {
+	// test
+	res, err := newK8sResolver(fake.NewSimpleClientset(), zap.NewNop(), "", nil)
+
+	// verify
+	assert.Nil(t, res)
+	assert.Equal(t, errNoSvc, err)
+}
+
+func TestNewK8sResolverServiceAndNamespace(t *testing.T) {
+	for _, tt := range []struct {
+		service           string
+		expectedName      string
+		expectedNamespace string
+	}{
+		{service: "lb", expectedName: "lb", expectedNamespace: "default"},
+		{service: "lb.observability", expectedName: "lb", expectedNamespace: "observability"},
+	} {
+		t.Run(tt.service, func(t *testing.T) {
+			res, err := newK8sResolver(fake.NewSimpleClientset(), zap.NewNop(), tt.service, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedName, res.svcName)
+			assert.Equal(t, tt.expectedNamespace, res.svcNs)
+			assert.Equal(t, []int32{4317}, res.ports)
+		})
+	}
+}
+
+func TestInitialK8sResolution(t *testing.T) {
+	// prepare
+	clt := fake.NewSimpleClientset(
+		newEndpoints("lb", "observability", "10.0.0.2", "10.0.0.1"),
+		newEndpoints("other", "observability", "10.0.0.3"),
+	)
+	res, err := newK8sResolver(clt, zap.NewNop(), "lb.observability", []int32{4317, 55690})
+	require.NoError(t, err)
+
+	recorder := &endpointsRecorder{}
+	res.onChange(recorder.onChange)
+
+	// test
+	require.NoError(t, res.start(context.Background()))
+	defer func() {
+		require.NoError(t, res.shutdown(context.Background()))
+	}()
+
+	// verify
+	expected := []string{"10.0.0.1:4317", "10.0.0.1:55690", "10.0.0.2:4317", "10.0.0.2:55690"}
+	assert.Equal(t, expected, recorder.get())
+	resolved, err := res.resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, expected, resolved)
+}
+
+func TestK8sResolverFollowsChanges(t *testing.T) {
+	// prepare
+	eps := newEndpoints("lb", "observability", "10.0.0.1")
+	clt := fake.NewSimpleClientset(eps)
+	res, err := newK8sResolver(clt, zap.NewNop(), "lb.observability", nil)
+	require.NoError(t, err)
+
+	recorder := &endpointsRecorder{}
+	res.onChange(recorder.onChange)
+	require.NoError(t, res.start(context.Background()))
+	defer func() {
+		require.NoError(t, res.shutdown(context.Background()))
+	}()
+	require.Equal(t, []string{"10.0.0.1:4317"}, recorder.get())
+
+	// test: a pod is added
+	updated := newEndpoints("lb", "observability", "10.0.0.1", "10.0.0.2")
+	_, err = clt.CoreV1().Endpoints("observability").Update(context.Background(), updated, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// verify
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"10.0.0.1:4317", "10.0.0.2:4317"}, recorder.get())
+	}, time.Second, 10*time.Millisecond)
+
+	// test: the service is gone
+	require.NoError(t, clt.CoreV1().Endpoints("observability").Delete(context.Background(), "lb", metav1.DeleteOptions{}))
+
+	// verify
+	assert.Eventually(t, func() bool {
+		return len(recorder.get()) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestK8sResolverNoCallbacksAfterShutdown(t *testing.T) {
+	// prepare
+	clt := fake.NewSimpleClientset(newEndpoints("lb", "default", "10.0.0.1"))
+	res, err := newK8sResolver(clt, zap.NewNop(), "lb", nil)
+	require.NoError(t, err)
+
+	recorder := &endpointsRecorder{}
+	res.onChange(recorder.onChange)
+	require.NoError(t, res.start(context.Background()))
+
+	// test
+	require.NoError(t, res.shutdown(context.Background()))
+	res.update([]string{"10.0.0.9:4317"})
+
+	// verify
+	assert.Equal(t, []string{"10.0.0.1:4317"}, recorder.get())
+}
@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"errors"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+var errNoRoutingAttributes = errors.New("routing_key 'attributes' requires at least one entry in routing_attributes")
+
+// attributesRoutingKey builds the routing key out of the values of the given attributes.
+// Each attribute is taken from the first map containing it, so that, for instance,
+// a span attribute takes precedence over a resource attribute with the same name.
+func attributesRoutingKey(attrs []string, maps ...pcommon.Map) string {
+	var b strings.Builder
+	for _, attr := range attrs {
+		for _, m := range maps {
+			if v, ok := m.Get(attr); ok {
+				b.WriteString(v.AsString())
+				break
+			}
+		}
+		// separate the values, so that "ab" and "" differ from "a" and "b"
+		b.WriteByte(0)
+	}
+	return b.String()
+}
@@ -25,8 +25,9 @@ import (
 var _ exporter.Traces = (*traceExporterImp)(nil)
 
 type traceExporterImp struct {
-	loadBalancer loadBalancer
-	routingKey   routingKey
+	loadBalancer      loadBalancer
+	routingKey        routingKey
+	routingAttributes []string
 
 	stopped    bool
 	shutdownWg sync.WaitGroup
@@ -49,6 +50,12 @@ func newTracesExporter(params exporter.CreateSettings, cfg component.Config) (*t
 	switch cfg.(*Config).RoutingKey {
 	case "service":
 		traceExporter.routingKey = svcRouting
+	case "attributes":
+		if len(cfg.(*Config).RoutingAttributes) == 0 {
+			return nil, errNoRoutingAttributes
+		}
+		traceExporter.routingKey = attrRouting
+		traceExporter.routingAttributes = cfg.(*Config).RoutingAttributes
 	case "traceID", "":
 	default:
 		return nil, fmt.Errorf("unsupported routing_key: %s", cfg.(*Config).RoutingKey)
@@ -78,6 +85,13 @@ func (e *traceExporterImp) Shutdown(context.Context) error {
 
 func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 	var errs error
+	if e.routingKey == attrRouting {
+		for rid, batch := range splitTracesByAttributes(td, e.routingAttributes) {
+			errs = multierr.Append(errs, e.exportTrace(ctx, batch, rid))
+		}
+		return errs
+	}
+
 	batches := batchpersignal.SplitTraces(td)
 	for _, batch := range batches {
 		errs = multierr.Append(errs, e.consumeTrace(ctx, batch))
@@ -87,38 +101,44 @@ func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 }
 
 func (e *traceExporterImp) consumeTrace(ctx context.Context, td ptrace.Traces) error {
-	var exp component.Component
 	routingIds, err := routingIdentifiersFromTraces(td, e.routingKey)
 	if err != nil {
 		return err
 	}
+	var errs error
 	for rid := range routingIds {
-		endpoint := e.loadBalancer.Endpoint([]byte(rid))
-		exp, err = e.loadBalancer.Exporter(endpoint)
-		if err != nil {
-			return err
-		}
+		errs = multierr.Append(errs, e.exportTrace(ctx, td, rid))
+	}
+	return errs
+}
 
-		te, ok := exp.(exporter.Traces)
-		if !ok {
-			return fmt.Errorf("unable to export traces, unexpected exporter type: expected exporter.Traces but got %T", exp)
-		}
+// exportTrace sends the traces to the backend that the given routing identifier belongs to.
+func (e *traceExporterImp) exportTrace(ctx context.Context, td ptrace.Traces, rid string) error {
+	endpoint := e.loadBalancer.Endpoint([]byte(rid))
+	exp, err := e.loadBalancer.Exporter(endpoint)
+	if err != nil {
+		return err
+	}
 
-		start := time.Now()
-		err = te.ConsumeTraces(ctx, td)
-		duration := time.Since(start)
-
-		if err == nil {
-			_ = stats.RecordWithTags(
-				ctx,
-				[]tag.Mutator{tag.Upsert(endpointTagKey, endpoint), successTrueMutator},
-				mBackendLatency.M(duration.Milliseconds()))
-		} else {
-			_ = stats.RecordWithTags(
-				ctx,
-				[]tag.Mutator{tag.Upsert(endpointTagKey, endpoint), successFalseMutator},
-				mBackendLatency.M(duration.Milliseconds()))
-		}
+	te, ok := exp.(exporter.Traces)
+	if !ok {
+		return fmt.Errorf("unable to export traces, unexpected exporter type: expected exporter.Traces but got %T", exp)
+	}
+
+	start := time.Now()
+	err = te.ConsumeTraces(ctx, td)
+	duration := time.Since(start)
+
+	if err == nil {
+		_ = stats.RecordWithTags(
+			ctx,
+			[]tag.Mutator{tag.Upsert(endpointTagKey, endpoint), successTrueMutator},
+			mBackendLatency.M(duration.Milliseconds()))
+	} else {
+		_ = stats.RecordWithTags(
+			ctx,
+			[]tag.Mutator{tag.Upsert(endpointTagKey, endpoint), successFalseMutator},
+			mBackendLatency.M(duration.Milliseconds()))
 	}
 	return err
 }
@@ -154,3 +174,44 @@ func routingIdentifiersFromTraces(td ptrace.Traces, key routingKey) (map[string]
 	ids[string(tid[:])] = true
 	return ids, nil
 }
+
+// splitTracesByAttributes groups the spans by the values of the routing attributes,
+// looked up first in the span attributes and then in the resource attributes.
+func splitTracesByAttributes(td ptrace.Traces, attrs []string) map[string]ptrace.Traces {
+	batches := make(map[string]ptrace.Traces)
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		rsByKey := make(map[string]ptrace.ResourceSpans)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			ssByKey := make(map[string]ptrace.ScopeSpans)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				key := attributesRoutingKey(attrs, span.Attributes(), rs.Resource().Attributes())
+
+				dest, ok := ssByKey[key]
+				if !ok {
+					destRS, found := rsByKey[key]
+					if !found {
+						batch, exists := batches[key]
+						if !exists {
+							batch = ptrace.NewTraces()
+							batches[key] = batch
+						}
+						destRS = batch.ResourceSpans().AppendEmpty()
+						rs.Resource().CopyTo(destRS.Resource())
+						destRS.SetSchemaUrl(rs.SchemaUrl())
+						rsByKey[key] = destRS
+					}
+					dest = destRS.ScopeSpans().AppendEmpty()
+					ss.Scope().CopyTo(dest.Scope())
+					dest.SetSchemaUrl(ss.SchemaUrl())
+					ssByKey[key] = dest
+				}
+				span.CopyTo(dest.Spans().AppendEmpty())
+			}
+		}
+	}
+	return batches
+}
@@ -47,6 +47,14 @@ func TestNewTracesExporter(t *testing.T) {
 			&Config{},
 			errNoResolver,
 		},
+		{
+			"attributes without routing attributes",
+			&Config{
+				Resolver:   simpleConfig().Resolver,
+				RoutingKey: "attributes",
+			},
+			errNoRoutingAttributes,
+		},
 	} {
 		t.Run(tt.desc, func(t *testing.T) {
 			// test
@@ -346,6 +354,72 @@ func TestBatchWithTwoTraces(t *testing.T) {
 	assert.Len(t, sink.AllTraces(), 2)
 }
 
+func TestConsumeTracesAttributeBased(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	componentFactory := func(ctx context.Context, endpoint string) (component.Component, error) {
+		return newMockTracesExporter(sink.ConsumeTraces), nil
+	}
+	cfg := attributeBasedRoutingConfig()
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, lb)
+	require.NoError(t, err)
+
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+	assert.Equal(t, attrRouting, p.routingKey)
+
+	p.loadBalancer = lb
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+	lb.addMissingExporters(context.Background(), []string{"endpoint-1"})
+
+	td := simpleTraces()
+	td.ResourceSpans().At(0).Resource().Attributes().PutStr("tenant.id", "acme")
+	appendSimpleTraceWithID(td.ResourceSpans().At(0), [16]byte{2, 3, 4, 5})
+	td.ResourceSpans().At(0).ScopeSpans().At(1).Spans().At(0).Attributes().PutStr("tenant.id", "other")
+
+	// test
+	err = p.ConsumeTraces(context.Background(), td)
+
+	// verify
+	assert.NoError(t, err)
+	assert.Len(t, sink.AllTraces(), 2)
+	assert.Equal(t, 2, sink.SpanCount())
+}
+
+func TestSplitTracesByAttributes(t *testing.T) {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("tenant.id", "acme")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().SetName("resource tenant")
+	other := spans.AppendEmpty()
+	other.SetName("span tenant")
+	other.Attributes().PutStr("tenant.id", "other")
+	spans.AppendEmpty().SetName("resource tenant again")
+
+	// test
+	batches := splitTracesByAttributes(td, []string{"tenant.id"})
+
+	// verify
+	require.Len(t, batches, 2)
+	acme := batches[attributesRoutingKey([]string{"tenant.id"}, rs.Resource().Attributes())]
+	require.Equal(t, 2, acme.SpanCount())
+	assert.Equal(t, 1, acme.ResourceSpans().Len())
+	acmeSpans := acme.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	assert.Equal(t, "resource tenant", acmeSpans.At(0).Name())
+	assert.Equal(t, "resource tenant again", acmeSpans.At(1).Name())
+
+	otherKey := attributesRoutingKey([]string{"tenant.id"}, other.Attributes())
+	require.Equal(t, 1, batches[otherKey].SpanCount())
+	otherRS := batches[otherKey].ResourceSpans().At(0)
+	v, _ := otherRS.Resource().Attributes().Get("tenant.id")
+	assert.Equal(t, "acme", v.Str(), "the resource is kept as it is")
+}
+
 func TestNoTracesInBatch(t *testing.T) {
 	for _, tt := range []struct {
 		desc       string
@@ -588,6 +662,16 @@ func serviceBasedRoutingConfig() *Config {
 	}
 }
 
+func attributeBasedRoutingConfig() *Config {
+	return &Config{
+		Resolver: ResolverSettings{
+			Static: &StaticResolver{Hostnames: []string{"endpoint-1"}},
+		},
+		RoutingKey:        "attributes",
+		RoutingAttributes: []string{"tenant.id"},
+	}
+}
+
 type mockTracesExporter struct {
 	component.Component
 	ConsumeTracesFn func(ctx context.Context, td ptrace.Traces) error
@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const (
+	compressionNone   = "none"
+	compressionSnappy = "snappy"
+	compressionGzip   = "gzip"
+	compressionZstd   = "zstd"
+
+	defaultMaxFileSize      = 128 * 1024 * 1024
+	defaultRotationInterval = time.Hour
+)
+
+// Config defines configuration for the Parquet exporter.
+type Config struct {
+	// Path is the directory the Parquet files are written to. Every signal
+	// is written to its own set of files since each has its own schema.
+	Path string `mapstructure:"path"`
+
+	// MaxFileSize is the size in bytes after which the current file is closed
+	// and a new one is started. Zero disables size based rotation.
+	MaxFileSize int64 `mapstructure:"max_file_size"`
+
+	// RotationInterval is the maximum amount of time a file is kept open
+	// before it is closed and a new one is started. Zero disables time based
+	// rotation.
+	RotationInterval time.Duration `mapstructure:"rotation_interval"`
+
+	// Compression is the codec used for the column chunks.
+	// Options: none, snappy, gzip, zstd[default].
+	Compression string `mapstructure:"compression"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Path == "" {
+		return errors.New("path must be non-empty")
+	}
+	if cfg.MaxFileSize < 0 {
+		return errors.New("max_file_size must not be negative")
+	}
+	if cfg.RotationInterval < 0 {
+		return errors.New("rotation_interval must not be negative")
+	}
+	if cfg.RotationInterval > 0 && cfg.RotationInterval < time.Second {
+		return errors.New("rotation_interval must be at least 1s")
+	}
+	switch cfg.Compression {
+	case compressionNone, compressionSnappy, compressionGzip, compressionZstd:
+	default:
+		return fmt.Errorf("compression %q is not supported", cfg.Compression)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				Path:             "/var/output/parquet",
+				MaxFileSize:      defaultMaxFileSize,
+				RotationInterval: defaultRotationInterval,
+				Compression:      compressionZstd,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom"),
+			expected: &Config{
+				Path:             "/var/output/parquet",
+				MaxFileSize:      1048576,
+				RotationInterval: 10 * time.Minute,
+				Compression:      compressionSnappy,
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_compression"),
+			errorMessage: `compression "lzma" is not supported`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expected == nil {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.errorMessage)
+				return
+			}
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.EqualError(t, cfg.Validate(), "path must be non-empty")
+
+	cfg.Path = t.TempDir()
+	cfg.MaxFileSize = -1
+	assert.EqualError(t, cfg.Validate(), "max_file_size must not be negative")
+
+	cfg.MaxFileSize = 0
+	cfg.RotationInterval = -time.Second
+	assert.EqualError(t, cfg.Validate(), "rotation_interval must not be negative")
+
+	cfg.RotationInterval = 5 * time.Nanosecond
+	assert.EqualError(t, cfg.Validate(), "rotation_interval must be at least 1s")
+}
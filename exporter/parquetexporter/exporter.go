@@ -5,33 +5,102 @@ package parquetexporter // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
 )
 
+// parquetExporter writes the data of a single signal to Parquet files.
+// Only the writer matching the pipeline it was created for is set.
 type parquetExporter struct {
-	path string
+	config *Config
+	logger *zap.Logger
+
+	spans   *fileWriter[spanRow]
+	metrics *fileWriter[metricRow]
+	logs    *fileWriter[logRow]
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
 }
 
-func (e parquetExporter) start(ctx context.Context, host component.Host) error {
-	return nil
+func newParquetExporter(cfg *Config, logger *zap.Logger) *parquetExporter {
+	return &parquetExporter{
+		config: cfg,
+		logger: logger,
+		stopCh: make(chan struct{}),
+	}
 }
 
-func (e parquetExporter) shutdown(ctx context.Context) error {
+func (e *parquetExporter) start(_ context.Context, _ component.Host) error {
+	if e.config.RotationInterval <= 0 {
+		return nil
+	}
+	e.wg.Add(1)
+	go e.rotateLoop()
 	return nil
 }
 
-func (e parquetExporter) consumeMetrics(ctx context.Context, ld pmetric.Metrics) error {
-	return nil
+// rotateLoop closes the files that have been open for longer than the
+// rotation interval, so they become readable even if no new data arrives.
+func (e *parquetExporter) rotateLoop() {
+	defer e.wg.Done()
+	// the files are checked ten times per interval, but never more often than
+	// every millisecond, and a zero duration would make the ticker panic
+	interval := e.config.RotationInterval / 10
+	if interval < time.Millisecond {
+		interval = time.Millisecond
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			if w := e.writer(); w != nil {
+				if err := w.rotateIfExpired(now); err != nil {
+					e.logger.Error("failed to rotate parquet file", zap.Error(err))
+				}
+			}
+		case <-e.stopCh:
+			return
+		}
+	}
 }
 
-func (e parquetExporter) consumeTraces(ctx context.Context, ld ptrace.Traces) error {
+func (e *parquetExporter) shutdown(_ context.Context) error {
+	close(e.stopCh)
+	e.wg.Wait()
+	if w := e.writer(); w != nil {
+		return w.close()
+	}
 	return nil
 }
 
-func (e parquetExporter) consumeLogs(ctx context.Context, ld plog.Logs) error {
+func (e *parquetExporter) writer() rotator {
+	switch {
+	case e.spans != nil:
+		return e.spans
+	case e.metrics != nil:
+		return e.metrics
+	case e.logs != nil:
+		return e.logs
+	}
 	return nil
 }
+
+func (e *parquetExporter) consumeMetrics(_ context.Context, md pmetric.Metrics) error {
+	return e.metrics.write(metricsToRows(md))
+}
+
+func (e *parquetExporter) consumeTraces(_ context.Context, td ptrace.Traces) error {
+	return e.spans.write(tracesToRows(td))
+}
+
+func (e *parquetExporter) consumeLogs(_ context.Context, ld plog.Logs) error {
+	return e.logs.write(logsToRows(ld))
+}
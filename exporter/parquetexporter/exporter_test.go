@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/reader"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func testConfig(t *testing.T) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Path = t.TempDir()
+	return cfg
+}
+
+func parquetFiles(t *testing.T, dir string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, "*.parquet"))
+	require.NoError(t, err)
+	return files
+}
+
+func readRows[T any](t *testing.T, path string) []T {
+	f, err := local.NewLocalFileReader(path)
+	require.NoError(t, err)
+	defer f.Close()
+	pr, err := reader.NewParquetReader(f, new(T), 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+	rows := make([]T, pr.GetNumRows())
+	require.NoError(t, pr.Read(&rows))
+	return rows
+}
+
+func TestConsumeTraces(t *testing.T) {
+	cfg := testConfig(t)
+	exp, err := NewFactory().CreateTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "checkout")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("lib")
+	span := ss.Spans().AppendEmpty()
+	span.SetName("GET /cart")
+	span.SetTraceID(pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}))
+	span.SetSpanID(pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8}))
+	span.SetStartTimestamp(pcommon.Timestamp(1000))
+	span.SetEndTimestamp(pcommon.Timestamp(3500))
+	span.Attributes().PutInt("http.status_code", 200)
+
+	require.NoError(t, exp.ConsumeTraces(context.Background(), td))
+	// The file is only visible once it has been finalized.
+	assert.Empty(t, parquetFiles(t, cfg.Path))
+	require.NoError(t, exp.Shutdown(context.Background()))
+
+	files := parquetFiles(t, cfg.Path)
+	require.Len(t, files, 1)
+	rows := readRows[spanRow](t, files[0])
+	require.Len(t, rows, 1)
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", rows[0].TraceID)
+	assert.Equal(t, "0102030405060708", rows[0].SpanID)
+	assert.Equal(t, "", rows[0].ParentSpanID)
+	assert.Equal(t, "GET /cart", rows[0].Name)
+	assert.Equal(t, int64(2500), rows[0].DurationNano)
+	assert.Equal(t, "checkout", rows[0].ServiceName)
+	assert.Equal(t, "lib", rows[0].ScopeName)
+	assert.Equal(t, map[string]string{"http.status_code": "200"}, rows[0].Attributes)
+}
+
+func TestConsumeMetrics(t *testing.T) {
+	cfg := testConfig(t)
+	exp, err := NewFactory().CreateMetricsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	sum := ms.AppendEmpty()
+	sum.SetName("requests")
+	sum.SetEmptySum().SetIsMonotonic(true)
+	sum.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	dp := sum.Sum().DataPoints().AppendEmpty()
+	dp.SetIntValue(42)
+	dp.Attributes().PutStr("method", "GET")
+	hist := ms.AppendEmpty()
+	hist.SetName("latency")
+	hdp := hist.SetEmptyHistogram().DataPoints().AppendEmpty()
+	hdp.SetCount(3)
+	hdp.SetSum(12.5)
+	hdp.BucketCounts().FromRaw([]uint64{1, 2})
+	hdp.ExplicitBounds().FromRaw([]float64{5})
+	sdp := ms.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty()
+	sdp.QuantileValues().AppendEmpty().SetQuantile(0.5)
+
+	require.NoError(t, exp.ConsumeMetrics(context.Background(), md))
+	require.NoError(t, exp.Shutdown(context.Background()))
+
+	files := parquetFiles(t, cfg.Path)
+	require.Len(t, files, 1)
+	rows := readRows[metricRow](t, files[0])
+	require.Len(t, rows, 3)
+
+	assert.Equal(t, "requests", rows[0].MetricName)
+	assert.Equal(t, "Sum", rows[0].Type)
+	assert.True(t, rows[0].IsMonotonic)
+	assert.Equal(t, "Cumulative", rows[0].AggregationTemporality)
+	require.NotNil(t, rows[0].Value)
+	assert.Equal(t, 42.0, *rows[0].Value)
+	assert.Nil(t, rows[0].Count)
+	assert.Equal(t, map[string]string{"method": "GET"}, rows[0].Attributes)
+
+	assert.Equal(t, "latency", rows[1].MetricName)
+	assert.Nil(t, rows[1].Value)
+	require.NotNil(t, rows[1].Count)
+	assert.Equal(t, int64(3), *rows[1].Count)
+	assert.Equal(t, []int64{1, 2}, rows[1].BucketCounts)
+	assert.Equal(t, []float64{5}, rows[1].ExplicitBounds)
+	assert.Nil(t, rows[1].Min)
+
+	assert.Equal(t, "Summary", rows[2].Type)
+	assert.Equal(t, []float64{0.5}, rows[2].Quantiles)
+}
+
+func TestConsumeLogs(t *testing.T) {
+	cfg := testConfig(t)
+	exp, err := NewFactory().CreateLogsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	ld := plog.NewLogs()
+	lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.Body().SetStr("hello world")
+	lr.SetSeverityNumber(plog.SeverityNumberWarn)
+	lr.SetTimestamp(pcommon.Timestamp(12345))
+
+	require.NoError(t, exp.ConsumeLogs(context.Background(), ld))
+	require.NoError(t, exp.Shutdown(context.Background()))
+
+	files := parquetFiles(t, cfg.Path)
+	require.Len(t, files, 1)
+	rows := readRows[logRow](t, files[0])
+	require.Len(t, rows, 1)
+	assert.Equal(t, "hello world", rows[0].Body)
+	assert.Equal(t, int32(plog.SeverityNumberWarn), rows[0].SeverityNumber)
+	assert.Equal(t, int64(12345), rows[0].TimeUnixNano)
+}
+
+func TestFileWriterRotatesBySize(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.MaxFileSize = 1
+	w := newFileWriter[logRow](cfg, "logs")
+
+	require.NoError(t, w.write([]logRow{{Body: "first"}}))
+	require.NoError(t, w.write([]logRow{{Body: "second"}}))
+	require.NoError(t, w.close())
+
+	files := parquetFiles(t, cfg.Path)
+	require.Len(t, files, 2)
+	for _, f := range files {
+		rows := readRows[logRow](t, f)
+		assert.Len(t, rows, 1)
+	}
+}
+
+func TestFileWriterRotatesByTime(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.RotationInterval = time.Minute
+	w := newFileWriter[logRow](cfg, "logs")
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+
+	require.NoError(t, w.write([]logRow{{Body: "first"}}))
+	require.NoError(t, w.rotateIfExpired(now.Add(30*time.Second)))
+	assert.Empty(t, parquetFiles(t, cfg.Path))
+
+	require.NoError(t, w.rotateIfExpired(now.Add(time.Minute)))
+	assert.Equal(t, []string{filepath.Join(cfg.Path, "logs-20230501T100000Z-1.parquet")}, parquetFiles(t, cfg.Path))
+
+	// A new file is opened on the next write, without clobbering the first one.
+	require.NoError(t, w.write([]logRow{{Body: "second"}}))
+	require.NoError(t, w.close())
+	assert.Len(t, parquetFiles(t, cfg.Path), 2)
+	_, err := os.Stat(filepath.Join(cfg.Path, "logs-20230501T100000Z-2.parquet"))
+	assert.NoError(t, err)
+}
@@ -13,10 +13,6 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter/internal/metadata"
 )
 
-type Config struct {
-	Path string `mapstructure:"path"`
-}
-
 // NewFactory creates a factory for the Parquet exporter.
 func NewFactory() exporter.Factory {
 	return exporter.NewFactory(
@@ -28,7 +24,11 @@ func NewFactory() exporter.Factory {
 }
 
 func createDefaultConfig() component.Config {
-	return &Config{}
+	return &Config{
+		MaxFileSize:      defaultMaxFileSize,
+		RotationInterval: defaultRotationInterval,
+		Compression:      compressionZstd,
+	}
 }
 
 func createTracesExporter(
@@ -36,7 +36,8 @@ func createTracesExporter(
 	set exporter.CreateSettings,
 	cfg component.Config,
 ) (exporter.Traces, error) {
-	fe := &parquetExporter{path: cfg.(*Config).Path}
+	fe := newParquetExporter(cfg.(*Config), set.Logger)
+	fe.spans = newFileWriter[spanRow](fe.config, "traces")
 	return exporterhelper.NewTracesExporter(
 		ctx,
 		set,
@@ -52,7 +53,8 @@ func createMetricsExporter(
 	set exporter.CreateSettings,
 	cfg component.Config,
 ) (exporter.Metrics, error) {
-	fe := &parquetExporter{path: cfg.(*Config).Path}
+	fe := newParquetExporter(cfg.(*Config), set.Logger)
+	fe.metrics = newFileWriter[metricRow](fe.config, "metrics")
 	return exporterhelper.NewMetricsExporter(
 		ctx,
 		set,
@@ -68,7 +70,8 @@ func createLogsExporter(
 	set exporter.CreateSettings,
 	cfg component.Config,
 ) (exporter.Logs, error) {
-	fe := &parquetExporter{path: cfg.(*Config).Path}
+	fe := newParquetExporter(cfg.(*Config), set.Logger)
+	fe.logs = newFileWriter[logRow](fe.config, "logs")
 	return exporterhelper.NewLogsExporter(
 		ctx,
 		set,
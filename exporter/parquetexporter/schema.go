@@ -0,0 +1,345 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+)
+
+// spanRow is the Parquet schema of a span. Each span is written as one row.
+type spanRow struct {
+	TraceID                string            `parquet:"name=trace_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	SpanID                 string            `parquet:"name=span_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ParentSpanID           string            `parquet:"name=parent_span_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TraceState             string            `parquet:"name=trace_state, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Name                   string            `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Kind                   string            `parquet:"name=kind, type=BYTE_ARRAY, convertedtype=UTF8"`
+	StartTimeUnixNano      int64             `parquet:"name=start_time_unix_nano, type=INT64, logicaltype=TIMESTAMP, logicaltype.isadjustedtoutc=true, logicaltype.unit=NANOS"`
+	EndTimeUnixNano        int64             `parquet:"name=end_time_unix_nano, type=INT64, logicaltype=TIMESTAMP, logicaltype.isadjustedtoutc=true, logicaltype.unit=NANOS"`
+	DurationNano           int64             `parquet:"name=duration_nano, type=INT64"`
+	StatusCode             string            `parquet:"name=status_code, type=BYTE_ARRAY, convertedtype=UTF8"`
+	StatusMessage          string            `parquet:"name=status_message, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Attributes             map[string]string `parquet:"name=attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	DroppedAttributesCount int32             `parquet:"name=dropped_attributes_count, type=INT32"`
+	EventsCount            int32             `parquet:"name=events_count, type=INT32"`
+	LinksCount             int32             `parquet:"name=links_count, type=INT32"`
+	ServiceName            string            `parquet:"name=service_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ResourceAttributes     map[string]string `parquet:"name=resource_attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	ResourceSchemaURL      string            `parquet:"name=resource_schema_url, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ScopeName              string            `parquet:"name=scope_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ScopeVersion           string            `parquet:"name=scope_version, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// metricRow is the Parquet schema of a metric data point. Each data point is
+// written as one row; the columns that do not apply to the metric type are
+// left empty.
+type metricRow struct {
+	MetricName             string            `parquet:"name=metric_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Description            string            `parquet:"name=description, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Unit                   string            `parquet:"name=unit, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Type                   string            `parquet:"name=type, type=BYTE_ARRAY, convertedtype=UTF8"`
+	AggregationTemporality string            `parquet:"name=aggregation_temporality, type=BYTE_ARRAY, convertedtype=UTF8"`
+	IsMonotonic            bool              `parquet:"name=is_monotonic, type=BOOLEAN"`
+	StartTimeUnixNano      int64             `parquet:"name=start_time_unix_nano, type=INT64, logicaltype=TIMESTAMP, logicaltype.isadjustedtoutc=true, logicaltype.unit=NANOS"`
+	TimeUnixNano           int64             `parquet:"name=time_unix_nano, type=INT64, logicaltype=TIMESTAMP, logicaltype.isadjustedtoutc=true, logicaltype.unit=NANOS"`
+	Value                  *float64          `parquet:"name=value, type=DOUBLE, repetitiontype=OPTIONAL"`
+	Count                  *int64            `parquet:"name=count, type=INT64, repetitiontype=OPTIONAL"`
+	Sum                    *float64          `parquet:"name=sum, type=DOUBLE, repetitiontype=OPTIONAL"`
+	Min                    *float64          `parquet:"name=min, type=DOUBLE, repetitiontype=OPTIONAL"`
+	Max                    *float64          `parquet:"name=max, type=DOUBLE, repetitiontype=OPTIONAL"`
+	BucketCounts           []int64           `parquet:"name=bucket_counts, type=LIST, convertedtype=LIST, valuetype=INT64"`
+	ExplicitBounds         []float64         `parquet:"name=explicit_bounds, type=LIST, convertedtype=LIST, valuetype=DOUBLE"`
+	Scale                  int32             `parquet:"name=scale, type=INT32"`
+	ZeroCount              int64             `parquet:"name=zero_count, type=INT64"`
+	PositiveOffset         int32             `parquet:"name=positive_offset, type=INT32"`
+	PositiveBucketCounts   []int64           `parquet:"name=positive_bucket_counts, type=LIST, convertedtype=LIST, valuetype=INT64"`
+	NegativeOffset         int32             `parquet:"name=negative_offset, type=INT32"`
+	NegativeBucketCounts   []int64           `parquet:"name=negative_bucket_counts, type=LIST, convertedtype=LIST, valuetype=INT64"`
+	Quantiles              []float64         `parquet:"name=quantiles, type=LIST, convertedtype=LIST, valuetype=DOUBLE"`
+	QuantileValues         []float64         `parquet:"name=quantile_values, type=LIST, convertedtype=LIST, valuetype=DOUBLE"`
+	Flags                  int32             `parquet:"name=flags, type=INT32"`
+	Attributes             map[string]string `parquet:"name=attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	ServiceName            string            `parquet:"name=service_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ResourceAttributes     map[string]string `parquet:"name=resource_attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	ScopeName              string            `parquet:"name=scope_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ScopeVersion           string            `parquet:"name=scope_version, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// logRow is the Parquet schema of a log record. Each log record is written as
+// one row.
+type logRow struct {
+	TimeUnixNano           int64             `parquet:"name=time_unix_nano, type=INT64, logicaltype=TIMESTAMP, logicaltype.isadjustedtoutc=true, logicaltype.unit=NANOS"`
+	ObservedTimeUnixNano   int64             `parquet:"name=observed_time_unix_nano, type=INT64, logicaltype=TIMESTAMP, logicaltype.isadjustedtoutc=true, logicaltype.unit=NANOS"`
+	SeverityNumber         int32             `parquet:"name=severity_number, type=INT32"`
+	SeverityText           string            `parquet:"name=severity_text, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Body                   string            `parquet:"name=body, type=BYTE_ARRAY, convertedtype=UTF8"`
+	TraceID                string            `parquet:"name=trace_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	SpanID                 string            `parquet:"name=span_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Flags                  int32             `parquet:"name=flags, type=INT32"`
+	Attributes             map[string]string `parquet:"name=attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	DroppedAttributesCount int32             `parquet:"name=dropped_attributes_count, type=INT32"`
+	ServiceName            string            `parquet:"name=service_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ResourceAttributes     map[string]string `parquet:"name=resource_attributes, type=MAP, convertedtype=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	ScopeName              string            `parquet:"name=scope_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ScopeVersion           string            `parquet:"name=scope_version, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+func tracesToRows(td ptrace.Traces) []spanRow {
+	rows := make([]spanRow, 0, td.SpanCount())
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		resAttrs := attributesToMap(rs.Resource().Attributes())
+		serviceName := serviceNameOf(rs.Resource())
+		ilss := rs.ScopeSpans()
+		for j := 0; j < ilss.Len(); j++ {
+			scope := ilss.At(j).Scope()
+			spans := ilss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				rows = append(rows, spanRow{
+					TraceID:                traceIDToHex(span.TraceID()),
+					SpanID:                 spanIDToHex(span.SpanID()),
+					ParentSpanID:           spanIDToHex(span.ParentSpanID()),
+					TraceState:             span.TraceState().AsRaw(),
+					Name:                   span.Name(),
+					Kind:                   span.Kind().String(),
+					StartTimeUnixNano:      int64(span.StartTimestamp()),
+					EndTimeUnixNano:        int64(span.EndTimestamp()),
+					DurationNano:           int64(span.EndTimestamp()) - int64(span.StartTimestamp()),
+					StatusCode:             span.Status().Code().String(),
+					StatusMessage:          span.Status().Message(),
+					Attributes:             attributesToMap(span.Attributes()),
+					EventsCount:            int32(span.Events().Len()),
+					LinksCount:             int32(span.Links().Len()),
+					ServiceName:            serviceName,
+					ResourceAttributes:     resAttrs,
+					ScopeName:              scope.Name(),
+					ScopeVersion:           scope.Version(),
+					ResourceSchemaURL:      rs.SchemaUrl(),
+					DroppedAttributesCount: int32(span.DroppedAttributesCount()),
+				})
+			}
+		}
+	}
+	return rows
+}
+
+func logsToRows(ld plog.Logs) []logRow {
+	rows := make([]logRow, 0, ld.LogRecordCount())
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		resAttrs := attributesToMap(rl.Resource().Attributes())
+		serviceName := serviceNameOf(rl.Resource())
+		slls := rl.ScopeLogs()
+		for j := 0; j < slls.Len(); j++ {
+			scope := slls.At(j).Scope()
+			records := slls.At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				lr := records.At(k)
+				rows = append(rows, logRow{
+					TimeUnixNano:           int64(lr.Timestamp()),
+					ObservedTimeUnixNano:   int64(lr.ObservedTimestamp()),
+					SeverityNumber:         int32(lr.SeverityNumber()),
+					SeverityText:           lr.SeverityText(),
+					Body:                   lr.Body().AsString(),
+					TraceID:                traceIDToHex(lr.TraceID()),
+					SpanID:                 spanIDToHex(lr.SpanID()),
+					Flags:                  int32(lr.Flags()),
+					Attributes:             attributesToMap(lr.Attributes()),
+					DroppedAttributesCount: int32(lr.DroppedAttributesCount()),
+					ServiceName:            serviceName,
+					ResourceAttributes:     resAttrs,
+					ScopeName:              scope.Name(),
+					ScopeVersion:           scope.Version(),
+				})
+			}
+		}
+	}
+	return rows
+}
+
+func metricsToRows(md pmetric.Metrics) []metricRow {
+	rows := make([]metricRow, 0, md.DataPointCount())
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		resAttrs := attributesToMap(rm.Resource().Attributes())
+		serviceName := serviceNameOf(rm.Resource())
+		ilms := rm.ScopeMetrics()
+		for j := 0; j < ilms.Len(); j++ {
+			scope := ilms.At(j).Scope()
+			metrics := ilms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+				base := metricRow{
+					MetricName:         metric.Name(),
+					Description:        metric.Description(),
+					Unit:               metric.Unit(),
+					Type:               metric.Type().String(),
+					ServiceName:        serviceName,
+					ResourceAttributes: resAttrs,
+					ScopeName:          scope.Name(),
+					ScopeVersion:       scope.Version(),
+				}
+				rows = appendMetricRows(rows, base, metric)
+			}
+		}
+	}
+	return rows
+}
+
+func appendMetricRows(rows []metricRow, base metricRow, metric pmetric.Metric) []metricRow {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			rows = append(rows, numberDataPointToRow(base, dps.At(i)))
+		}
+	case pmetric.MetricTypeSum:
+		base.AggregationTemporality = metric.Sum().AggregationTemporality().String()
+		base.IsMonotonic = metric.Sum().IsMonotonic()
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			rows = append(rows, numberDataPointToRow(base, dps.At(i)))
+		}
+	case pmetric.MetricTypeHistogram:
+		base.AggregationTemporality = metric.Histogram().AggregationTemporality().String()
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row := base
+			setDataPointFields(&row, dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+			row.Count = int64Ptr(int64(dp.Count()))
+			if dp.HasSum() {
+				row.Sum = float64Ptr(dp.Sum())
+			}
+			if dp.HasMin() {
+				row.Min = float64Ptr(dp.Min())
+			}
+			if dp.HasMax() {
+				row.Max = float64Ptr(dp.Max())
+			}
+			row.BucketCounts = uint64sToInt64s(dp.BucketCounts().AsRaw())
+			row.ExplicitBounds = dp.ExplicitBounds().AsRaw()
+			rows = append(rows, row)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		base.AggregationTemporality = metric.ExponentialHistogram().AggregationTemporality().String()
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row := base
+			setDataPointFields(&row, dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+			row.Count = int64Ptr(int64(dp.Count()))
+			if dp.HasSum() {
+				row.Sum = float64Ptr(dp.Sum())
+			}
+			if dp.HasMin() {
+				row.Min = float64Ptr(dp.Min())
+			}
+			if dp.HasMax() {
+				row.Max = float64Ptr(dp.Max())
+			}
+			row.Scale = dp.Scale()
+			row.ZeroCount = int64(dp.ZeroCount())
+			row.PositiveOffset = dp.Positive().Offset()
+			row.PositiveBucketCounts = uint64sToInt64s(dp.Positive().BucketCounts().AsRaw())
+			row.NegativeOffset = dp.Negative().Offset()
+			row.NegativeBucketCounts = uint64sToInt64s(dp.Negative().BucketCounts().AsRaw())
+			rows = append(rows, row)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			row := base
+			setDataPointFields(&row, dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+			row.Count = int64Ptr(int64(dp.Count()))
+			row.Sum = float64Ptr(dp.Sum())
+			qvs := dp.QuantileValues()
+			row.Quantiles = make([]float64, qvs.Len())
+			row.QuantileValues = make([]float64, qvs.Len())
+			for q := 0; q < qvs.Len(); q++ {
+				row.Quantiles[q] = qvs.At(q).Quantile()
+				row.QuantileValues[q] = qvs.At(q).Value()
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func numberDataPointToRow(base metricRow, dp pmetric.NumberDataPoint) metricRow {
+	row := base
+	setDataPointFields(&row, dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		row.Value = float64Ptr(float64(dp.IntValue()))
+	case pmetric.NumberDataPointValueTypeDouble:
+		row.Value = float64Ptr(dp.DoubleValue())
+	}
+	return row
+}
+
+func setDataPointFields(row *metricRow, start, ts pcommon.Timestamp, attrs pcommon.Map, flags pmetric.DataPointFlags) {
+	row.StartTimeUnixNano = int64(start)
+	row.TimeUnixNano = int64(ts)
+	row.Attributes = attributesToMap(attrs)
+	row.Flags = int32(flags)
+}
+
+// attributesToMap flattens the attributes to strings, which keeps the schema
+// stable regardless of the attribute value types.
+func attributesToMap(attrs pcommon.Map) map[string]string {
+	m := make(map[string]string, attrs.Len())
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		m[k] = v.AsString()
+		return true
+	})
+	return m
+}
+
+func serviceNameOf(res pcommon.Resource) string {
+	if v, ok := res.Attributes().Get(conventions.AttributeServiceName); ok {
+		return v.AsString()
+	}
+	return ""
+}
+
+func traceIDToHex(id pcommon.TraceID) string {
+	if id.IsEmpty() {
+		return ""
+	}
+	return id.String()
+}
+
+func spanIDToHex(id pcommon.SpanID) string {
+	if id.IsEmpty() {
+		return ""
+	}
+	return id.String()
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func float64Ptr(v float64) *float64 {
+	return &v
+}
+
+// uint64sToInt64s converts the counts to the signed type used by Parquet.
+func uint64sToInt64s(in []uint64) []int64 {
+	out := make([]int64, len(in))
+	for i, v := range in {
+		out[i] = int64(v)
+	}
+	return out
+}
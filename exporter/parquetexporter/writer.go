@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package parquetexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/parquetexporter"
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+	"go.uber.org/multierr"
+)
+
+// inProgressSuffix is appended to the name of the file that is currently
+// being written, so that readers never pick up a file without a footer.
+const inProgressSuffix = ".tmp"
+
+// rotator is implemented by the writers so the exporter can roll and close
+// them without knowing their row type.
+type rotator interface {
+	rotateIfExpired(now time.Time) error
+	close() error
+}
+
+// fileWriter writes rows of type T to a sequence of Parquet files in a
+// directory, starting a new file whenever the current one grows larger than
+// the configured size or stays open longer than the configured interval.
+type fileWriter[T any] struct {
+	dir         string
+	prefix      string
+	maxSize     int64
+	maxAge      time.Duration
+	compression parquet.CompressionCodec
+
+	mu       sync.Mutex
+	file     *os.File
+	counter  *countingWriter
+	writer   *writer.ParquetWriter
+	openedAt time.Time
+	sequence int
+	now      func() time.Time
+}
+
+var _ rotator = (*fileWriter[logRow])(nil)
+
+func newFileWriter[T any](cfg *Config, prefix string) *fileWriter[T] {
+	return &fileWriter[T]{
+		dir:         cfg.Path,
+		prefix:      prefix,
+		maxSize:     cfg.MaxFileSize,
+		maxAge:      cfg.RotationInterval,
+		compression: compressionCodec(cfg.Compression),
+		now:         time.Now,
+	}
+}
+
+func compressionCodec(name string) parquet.CompressionCodec {
+	switch name {
+	case compressionNone:
+		return parquet.CompressionCodec_UNCOMPRESSED
+	case compressionSnappy:
+		return parquet.CompressionCodec_SNAPPY
+	case compressionGzip:
+		return parquet.CompressionCodec_GZIP
+	default:
+		return parquet.CompressionCodec_ZSTD
+	}
+}
+
+// write appends the rows to the current file as a single row group.
+func (w *fileWriter[T]) write(rows []T) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.writer == nil {
+		if err := w.open(); err != nil {
+			return err
+		}
+	}
+	for i := range rows {
+		if err := w.writer.Write(rows[i]); err != nil {
+			return err
+		}
+	}
+	// Flushing after every batch writes the rows to disk as a row group,
+	// which keeps memory usage bounded and the file size accurate.
+	if err := w.writer.Flush(true); err != nil {
+		return err
+	}
+	if w.maxSize > 0 && w.counter.written >= w.maxSize {
+		return w.closeCurrent()
+	}
+	return nil
+}
+
+// rotateIfExpired closes the current file if it has been open for longer
+// than the rotation interval.
+func (w *fileWriter[T]) rotateIfExpired(now time.Time) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.writer == nil || w.maxAge <= 0 || now.Sub(w.openedAt) < w.maxAge {
+		return nil
+	}
+	return w.closeCurrent()
+}
+
+// close finalizes the current file, if any.
+func (w *fileWriter[T]) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.writer == nil {
+		return nil
+	}
+	return w.closeCurrent()
+}
+
+func (w *fileWriter[T]) open() error {
+	if err := os.MkdirAll(w.dir, 0o755); err != nil {
+		return err
+	}
+	w.openedAt = w.now()
+	var f *os.File
+	for f == nil {
+		w.sequence++
+		name := filepath.Join(w.dir, fmt.Sprintf("%s-%s-%d.parquet",
+			w.prefix, w.openedAt.UTC().Format("20060102T150405Z"), w.sequence))
+		// Skip names that are taken, e.g. by a previous run of the collector.
+		if _, err := os.Stat(name); err == nil {
+			continue
+		}
+		var err error
+		f, err = os.OpenFile(name+inProgressSuffix, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if os.IsExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	w.file = f
+	w.counter = &countingWriter{file: f}
+	pw, err := writer.NewParquetWriterFromWriter(w.counter, new(T), 1)
+	if err != nil {
+		return multierr.Append(err, w.abort())
+	}
+	pw.CompressionType = w.compression
+	w.writer = pw
+	return nil
+}
+
+// closeCurrent writes the footer of the current file and renames it to its
+// final name. It must be called with the lock held.
+func (w *fileWriter[T]) closeCurrent() error {
+	err := w.writer.WriteStop()
+	err = multierr.Append(err, w.file.Close())
+	if err == nil {
+		tmpName := w.file.Name()
+		err = os.Rename(tmpName, tmpName[:len(tmpName)-len(inProgressSuffix)])
+	}
+	w.writer = nil
+	w.counter = nil
+	w.file = nil
+	return err
+}
+
+// abort closes and removes the current file. It must be called with the lock
+// held.
+func (w *fileWriter[T]) abort() error {
+	name := w.file.Name()
+	err := multierr.Append(w.file.Close(), os.Remove(name))
+	w.writer = nil
+	w.counter = nil
+	w.file = nil
+	return err
+}
+
+// countingWriter keeps track of the number of bytes written to a file.
+type countingWriter struct {
+	file    *os.File
+	written int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.file.Write(p)
+	c.written += int64(n)
+	return n, err
+}
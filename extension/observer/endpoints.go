@@ -25,6 +25,10 @@ const (
 	PodType EndpointType = "pod"
 	// K8sNodeType is a Kubernetes Node endpoint.
 	K8sNodeType EndpointType = "k8s.node"
+	// K8sServiceType is a Kubernetes Service endpoint.
+	K8sServiceType EndpointType = "k8s.service"
+	// K8sIngressType is a Kubernetes Ingress endpoint.
+	K8sIngressType EndpointType = "k8s.ingress"
 	// HostPortType is a hostport endpoint.
 	HostPortType EndpointType = "hostport"
 	// ContainerType is a container endpoint.
@@ -35,6 +39,8 @@ var (
 	_ EndpointDetails = (*Pod)(nil)
 	_ EndpointDetails = (*Port)(nil)
 	_ EndpointDetails = (*K8sNode)(nil)
+	_ EndpointDetails = (*K8sService)(nil)
+	_ EndpointDetails = (*K8sIngress)(nil)
 	_ EndpointDetails = (*HostPort)(nil)
 	_ EndpointDetails = (*Container)(nil)
 )
@@ -262,3 +268,97 @@ func (n *K8sNode) Env() EndpointEnv {
 func (n *K8sNode) Type() EndpointType {
 	return K8sNodeType
 }
+
+// K8sService represents a Kubernetes Service object:
+// https://kubernetes.io/docs/concepts/services-networking/service/
+type K8sService struct {
+	// Name of the service.
+	Name string
+	// UID is the unique ID in the cluster for the service.
+	UID string
+	// Labels is a map of user-specified metadata.
+	Labels map[string]string
+	// Annotations is a map of user-specified metadata.
+	Annotations map[string]string
+	// Namespace must be unique for services with same name.
+	Namespace string
+	// ClusterIP is the IP address of the service, "None" for headless services.
+	ClusterIP string
+	// ServiceType is the type of the service: ClusterIP, NodePort, LoadBalancer or ExternalName.
+	ServiceType string
+	// Ports are the ports exposed by the service.
+	Ports []K8sServicePort
+}
+
+// K8sServicePort is a port exposed by a Kubernetes Service.
+type K8sServicePort struct {
+	// Name of the service port, empty for services with a single port.
+	Name string
+	// Port number exposed by the service.
+	Port uint16
+	// Transport is the transport protocol used by the port. (TCP or UDP).
+	Transport Transport
+}
+
+func (s *K8sService) Env() EndpointEnv {
+	ports := make([]map[string]interface{}, 0, len(s.Ports))
+	for _, port := range s.Ports {
+		ports = append(ports, map[string]interface{}{
+			"name":      port.Name,
+			"port":      port.Port,
+			"transport": port.Transport,
+		})
+	}
+	return map[string]interface{}{
+		"uid":          s.UID,
+		"name":         s.Name,
+		"labels":       s.Labels,
+		"annotations":  s.Annotations,
+		"namespace":    s.Namespace,
+		"cluster_ip":   s.ClusterIP,
+		"service_type": s.ServiceType,
+		"ports":        ports,
+	}
+}
+
+func (s *K8sService) Type() EndpointType {
+	return K8sServiceType
+}
+
+// K8sIngress represents a path of a rule of a Kubernetes Ingress object:
+// https://kubernetes.io/docs/concepts/services-networking/ingress/
+type K8sIngress struct {
+	// Name of the ingress.
+	Name string
+	// UID is the unique ID in the cluster for the ingress.
+	UID string
+	// Labels is a map of user-specified metadata.
+	Labels map[string]string
+	// Annotations is a map of user-specified metadata.
+	Annotations map[string]string
+	// Namespace must be unique for ingresses with same name.
+	Namespace string
+	// Scheme is the scheme of the rule, https if its host is covered by the TLS configuration, http otherwise.
+	Scheme string
+	// Host is the host of the rule, empty if the rule applies to all the inbound traffic.
+	Host string
+	// Path is the path of the rule.
+	Path string
+}
+
+func (i *K8sIngress) Env() EndpointEnv {
+	return map[string]interface{}{
+		"uid":         i.UID,
+		"name":        i.Name,
+		"labels":      i.Labels,
+		"annotations": i.Annotations,
+		"namespace":   i.Namespace,
+		"scheme":      i.Scheme,
+		"host":        i.Host,
+		"path":        i.Path,
+	}
+}
+
+func (i *K8sIngress) Type() EndpointType {
+	return K8sIngressType
+}
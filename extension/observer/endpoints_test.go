@@ -194,6 +194,86 @@ func TestEndpointEnv(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Kubernetes Service",
+			endpoint: Endpoint{
+				ID:     EndpointID("k8s_service_endpoint_id"),
+				Target: "1.2.3.4",
+				Details: &K8sService{
+					Name:      "a-k8s-service",
+					UID:       "a-k8s-service-uid",
+					Namespace: "a-k8s-namespace",
+					Annotations: map[string]string{
+						"annotation_key": "annotation_val",
+					},
+					Labels: map[string]string{
+						"label_key": "label_val",
+					},
+					ClusterIP:   "1.2.3.4",
+					ServiceType: "ClusterIP",
+					Ports: []K8sServicePort{
+						{Name: "http", Port: 80, Transport: ProtocolTCP},
+					},
+				},
+			},
+			want: EndpointEnv{
+				"type":         "k8s.service",
+				"id":           "k8s_service_endpoint_id",
+				"endpoint":     "1.2.3.4",
+				"name":         "a-k8s-service",
+				"uid":          "a-k8s-service-uid",
+				"namespace":    "a-k8s-namespace",
+				"cluster_ip":   "1.2.3.4",
+				"service_type": "ClusterIP",
+				"annotations": map[string]string{
+					"annotation_key": "annotation_val",
+				},
+				"labels": map[string]string{
+					"label_key": "label_val",
+				},
+				"ports": []map[string]interface{}{
+					{"name": "http", "port": uint16(80), "transport": ProtocolTCP},
+				},
+			},
+		},
+		{
+			name: "Kubernetes Ingress",
+			endpoint: Endpoint{
+				ID:     EndpointID("k8s_ingress_endpoint_id"),
+				Target: "https://host-1/path",
+				Details: &K8sIngress{
+					Name:      "a-k8s-ingress",
+					UID:       "a-k8s-ingress-uid",
+					Namespace: "a-k8s-namespace",
+					Annotations: map[string]string{
+						"annotation_key": "annotation_val",
+					},
+					Labels: map[string]string{
+						"label_key": "label_val",
+					},
+					Scheme: "https",
+					Host:   "host-1",
+					Path:   "/path",
+				},
+			},
+			want: EndpointEnv{
+				"type":      "k8s.ingress",
+				"id":        "k8s_ingress_endpoint_id",
+				"endpoint":  "https://host-1/path",
+				"name":      "a-k8s-ingress",
+				"uid":       "a-k8s-ingress-uid",
+				"namespace": "a-k8s-namespace",
+				"scheme":    "https",
+				"host":      "host-1",
+				"path":      "/path",
+				"annotations": map[string]string{
+					"annotation_key": "annotation_val",
+				},
+				"labels": map[string]string{
+					"label_key": "label_val",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -219,3 +219,98 @@ func TestExtensionObserveNodes(t *testing.T) {
 
 	require.NoError(t, ext.Shutdown(context.Background()))
 }
+
+func TestExtensionObserveServices(t *testing.T) {
+	factory := NewFactory()
+	config := factory.CreateDefaultConfig().(*Config)
+	mockServiceHost(t, config)
+
+	set := extensiontest.NewNopCreateSettings()
+	set.ID = component.NewID(metadata.Type)
+	ext, err := newObserver(config, set)
+	require.NoError(t, err)
+	require.NotNil(t, ext)
+
+	obs := ext.(*k8sObserver)
+	serviceListerWatcher := framework.NewFakeControllerSource()
+	obs.serviceListerWatcher = serviceListerWatcher
+
+	serviceListerWatcher.Add(service1V1)
+
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+
+	sink := &endpointSink{}
+	obs.ListAndWatch(sink)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.added) == 1
+	})
+
+	assert.Equal(t, convertServiceToEndpoint("k8s_observer", service1V1), sink.added[0])
+	assert.Equal(t, "1.2.3.4", sink.added[0].Target)
+
+	serviceListerWatcher.Modify(service1V2)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.changed) == 1
+	})
+
+	assert.Equal(t, convertServiceToEndpoint("k8s_observer", service1V2), sink.changed[0])
+
+	serviceListerWatcher.Delete(service1V2)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.removed) == 1
+	})
+
+	assert.Equal(t, observer.EndpointID("k8s_observer/uid"), sink.removed[0].ID)
+
+	require.NoError(t, ext.Shutdown(context.Background()))
+}
+
+func TestExtensionObserveIngresses(t *testing.T) {
+	factory := NewFactory()
+	config := factory.CreateDefaultConfig().(*Config)
+	mockServiceHost(t, config)
+
+	set := extensiontest.NewNopCreateSettings()
+	set.ID = component.NewID(metadata.Type)
+	ext, err := newObserver(config, set)
+	require.NoError(t, err)
+	require.NotNil(t, ext)
+
+	obs := ext.(*k8sObserver)
+	ingressListerWatcher := framework.NewFakeControllerSource()
+	obs.ingressListerWatcher = ingressListerWatcher
+
+	ingressListerWatcher.Add(ingress1V1)
+
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+
+	sink := &endpointSink{}
+	obs.ListAndWatch(sink)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.added) == 2
+	})
+
+	assert.ElementsMatch(t, convertIngressToEndpoints("k8s_observer", ingress1V1), sink.added)
+
+	ingressListerWatcher.Modify(ingress1V2)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.changed) == 2
+	})
+
+	assert.ElementsMatch(t, convertIngressToEndpoints("k8s_observer", ingress1V2), sink.changed)
+
+	ingressListerWatcher.Delete(ingress1V2)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.removed) == 2
+	})
+
+	assert.ElementsMatch(t, convertIngressToEndpoints("k8s_observer", ingress1V2), sink.removed)
+
+	require.NoError(t, ext.Shutdown(context.Background()))
+}
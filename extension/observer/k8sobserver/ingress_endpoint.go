@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/k8sobserver"
+
+import (
+	"fmt"
+
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+// convertIngressToEndpoints converts an ingress instance into a slice of k8s.ingress observer.Endpoints, one
+// for each path of each rule. The scheme of the endpoints is https if the host of the rule is covered by the
+// TLS configuration of the ingress.
+func convertIngressToEndpoints(idNamespace string, ingress *networkingv1.Ingress) []observer.Endpoint {
+	tlsHosts := map[string]bool{}
+	for _, tls := range ingress.Spec.TLS {
+		for _, host := range tls.Hosts {
+			tlsHosts[host] = true
+		}
+	}
+
+	var endpoints []observer.Endpoint
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		scheme := "http"
+		if tlsHosts[rule.Host] {
+			scheme = "https"
+		}
+		for _, path := range rule.HTTP.Paths {
+			endpoints = append(endpoints, observer.Endpoint{
+				ID:     observer.EndpointID(fmt.Sprintf("%s/%s/%s%s", idNamespace, ingress.UID, rule.Host, path.Path)),
+				Target: fmt.Sprintf("%s://%s%s", scheme, rule.Host, path.Path),
+				Details: &observer.K8sIngress{
+					Name:        ingress.Name,
+					UID:         string(ingress.UID),
+					Labels:      ingress.Labels,
+					Annotations: ingress.Annotations,
+					Namespace:   ingress.Namespace,
+					Scheme:      scheme,
+					Host:        rule.Host,
+					Path:        path.Path,
+				},
+			})
+		}
+	}
+	return endpoints
+}
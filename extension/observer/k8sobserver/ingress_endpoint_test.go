@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sobserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func TestIngressObjectToK8sIngressEndpoints(t *testing.T) {
+	expectedEndpoints := []observer.Endpoint{
+		{
+			ID:     "namespace/uid/secure.example.com/",
+			Target: "https://secure.example.com/",
+			Details: &observer.K8sIngress{
+				Name:        "name",
+				UID:         "uid",
+				Annotations: map[string]string{"annotation-key": "annotation-value"},
+				Labels:      map[string]string{"label-key": "label-value"},
+				Namespace:   "namespace",
+				Scheme:      "https",
+				Host:        "secure.example.com",
+				Path:        "/",
+			},
+		},
+		{
+			ID:     "namespace/uid/example.com/api",
+			Target: "http://example.com/api",
+			Details: &observer.K8sIngress{
+				Name:        "name",
+				UID:         "uid",
+				Annotations: map[string]string{"annotation-key": "annotation-value"},
+				Labels:      map[string]string{"label-key": "label-value"},
+				Namespace:   "namespace",
+				Scheme:      "http",
+				Host:        "example.com",
+				Path:        "/api",
+			},
+		},
+	}
+
+	endpoints := convertIngressToEndpoints("namespace", NewIngress("name"))
+	require.Equal(t, expectedEndpoints, endpoints)
+}
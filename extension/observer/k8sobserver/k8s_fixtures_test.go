@@ -5,6 +5,7 @@ package k8sobserver
 
 import (
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -153,3 +154,87 @@ var node1V2 = func() *v1.Node {
 	node.Labels["node-version"] = "2"
 	return node
 }()
+
+// NewService is a helper function for creating Services for testing.
+func NewService(name, clusterIP string) *v1.Service {
+	return &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "namespace",
+			Name:      name,
+			UID:       "uid",
+			Labels: map[string]string{
+				"label-key": "label-value",
+			},
+			Annotations: map[string]string{
+				"annotation-key": "annotation-value",
+			},
+		},
+		Spec: v1.ServiceSpec{
+			Type:      v1.ServiceTypeClusterIP,
+			ClusterIP: clusterIP,
+			Ports: []v1.ServicePort{
+				{Name: "http", Port: 80, Protocol: v1.ProtocolTCP},
+				{Name: "dns", Port: 53, Protocol: v1.ProtocolUDP},
+			},
+		},
+	}
+}
+
+var service1V1 = NewService("service1", "1.2.3.4")
+var service1V2 = func() *v1.Service {
+	service := service1V1.DeepCopy()
+	service.Labels["service-version"] = "2"
+	return service
+}()
+
+// NewIngress is a helper function for creating Ingresses for testing.
+func NewIngress(name string) *networkingv1.Ingress {
+	pathType := networkingv1.PathTypePrefix
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "namespace",
+			Name:      name,
+			UID:       "uid",
+			Labels: map[string]string{
+				"label-key": "label-value",
+			},
+			Annotations: map[string]string{
+				"annotation-key": "annotation-value",
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			TLS: []networkingv1.IngressTLS{
+				{Hosts: []string{"secure.example.com"}},
+			},
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: "secure.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{Path: "/", PathType: &pathType},
+							},
+						},
+					},
+				},
+				{
+					Host: "example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{Path: "/api", PathType: &pathType},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var ingress1V1 = NewIngress("ingress1")
+var ingress1V2 = func() *networkingv1.Ingress {
+	ingress := ingress1V1.DeepCopy()
+	ingress.Labels["ingress-version"] = "2"
+	return ingress
+}()
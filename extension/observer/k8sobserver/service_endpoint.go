@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/k8sobserver"
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+// convertServiceToEndpoint converts a service instance into a k8s.service observer.Endpoint. The Target is
+// the cluster IP of the service, or its cluster DNS name for headless services.
+func convertServiceToEndpoint(idNamespace string, service *v1.Service) observer.Endpoint {
+	serviceID := observer.EndpointID(fmt.Sprintf("%s/%s", idNamespace, service.UID))
+
+	ports := make([]observer.K8sServicePort, 0, len(service.Spec.Ports))
+	for _, port := range service.Spec.Ports {
+		ports = append(ports, observer.K8sServicePort{
+			Name:      port.Name,
+			Port:      uint16(port.Port),
+			Transport: getTransport(port.Protocol),
+		})
+	}
+
+	target := service.Spec.ClusterIP
+	if target == "" || target == v1.ClusterIPNone {
+		target = fmt.Sprintf("%s.%s.svc", service.Name, service.Namespace)
+	}
+
+	serviceDetails := observer.K8sService{
+		Name:        service.Name,
+		UID:         string(service.UID),
+		Labels:      service.Labels,
+		Annotations: service.Annotations,
+		Namespace:   service.Namespace,
+		ClusterIP:   service.Spec.ClusterIP,
+		ServiceType: string(service.Spec.Type),
+		Ports:       ports,
+	}
+
+	return observer.Endpoint{
+		ID:      serviceID,
+		Target:  target,
+		Details: &serviceDetails,
+	}
+}
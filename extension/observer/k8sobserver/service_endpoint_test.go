@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sobserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func TestServiceObjectToK8sServiceEndpoint(t *testing.T) {
+	expectedService := observer.Endpoint{
+		ID:     "namespace/uid",
+		Target: "1.2.3.4",
+		Details: &observer.K8sService{
+			Name:        "name",
+			UID:         "uid",
+			Annotations: map[string]string{"annotation-key": "annotation-value"},
+			Labels:      map[string]string{"label-key": "label-value"},
+			Namespace:   "namespace",
+			ClusterIP:   "1.2.3.4",
+			ServiceType: "ClusterIP",
+			Ports: []observer.K8sServicePort{
+				{Name: "http", Port: 80, Transport: observer.ProtocolTCP},
+				{Name: "dns", Port: 53, Transport: observer.ProtocolUDP},
+			},
+		},
+	}
+
+	endpoint := convertServiceToEndpoint("namespace", NewService("name", "1.2.3.4"))
+	require.Equal(t, expectedService, endpoint)
+}
+
+func TestHeadlessServiceObjectToK8sServiceEndpoint(t *testing.T) {
+	endpoint := convertServiceToEndpoint("namespace", NewService("name", v1.ClusterIPNone))
+	require.Equal(t, "name.namespace.svc", endpoint.Target)
+	require.Equal(t, v1.ClusterIPNone, endpoint.Details.(*observer.K8sService).ClusterIP)
+}
@@ -8,72 +8,102 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	// Postgres driver
 	_ "github.com/jackc/pgx/v4/stdlib"
 	// SQLite driver
 	_ "github.com/mattn/go-sqlite3"
 	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/multierr"
 )
 
 const (
-	createTable     = "create table if not exists %s (key text primary key, value blob)"
-	getQueryText    = "select value from %s where key=?"
-	setQueryText    = "insert into %s(key, value) values(?,?) on conflict(key) do update set value=?"
-	deleteQueryText = "delete from %s where key=?"
+	createTable      = "create table if not exists %s (key text primary key, value %s, expires_at bigint)"
+	checkExpiresText = "select expires_at from %s where 1=0"
+	addExpiresText   = "alter table %s add column expires_at bigint"
+	getQueryText     = "select value from %s where key=%s and (expires_at is null or expires_at > %s)"
+	setQueryText     = "insert into %s(key, value, expires_at) values(%s,%s,%s) on conflict(key) do update set value=excluded.value, expires_at=excluded.expires_at"
+	deleteQueryText  = "delete from %s where key=%s"
+	cleanupQueryText = "delete from %s where expires_at is not null and expires_at <= %s"
 )
 
+// dialect holds the differences between the supported databases.
+type dialect struct {
+	// blobType is the column type for binary values
+	blobType string
+	// placeholder returns the placeholder of the n-th (1-based) query parameter
+	placeholder func(n int) string
+}
+
+var (
+	sqliteDialect = dialect{
+		blobType:    "blob",
+		placeholder: func(int) string { return "?" },
+	}
+	postgresDialect = dialect{
+		blobType:    "bytea",
+		placeholder: func(n int) string { return fmt.Sprintf("$%d", n) },
+	}
+)
+
+func dialectFor(driverName string) dialect {
+	switch driverName {
+	case "pgx", "postgres":
+		return postgresDialect
+	default:
+		return sqliteDialect
+	}
+}
+
 type dbStorageClient struct {
 	db          *sql.DB
+	ttl         time.Duration
+	now         func() time.Time
 	getQuery    *sql.Stmt
 	setQuery    *sql.Stmt
 	deleteQuery *sql.Stmt
 }
 
-func newClient(ctx context.Context, db *sql.DB, tableName string) (*dbStorageClient, error) {
+func newClient(ctx context.Context, db *sql.DB, d dialect, tableName string, ttl time.Duration) (*dbStorageClient, error) {
 	var err error
-	_, err = db.ExecContext(ctx, fmt.Sprintf(createTable, tableName))
+	_, err = db.ExecContext(ctx, fmt.Sprintf(createTable, tableName, d.blobType))
 	if err != nil {
 		return nil, err
 	}
-
-	selectQuery, err := db.PrepareContext(ctx, fmt.Sprintf(getQueryText, tableName))
-	if err != nil {
-		return nil, err
+	// tables created by earlier versions don't have the expiry column yet
+	if _, err = db.ExecContext(ctx, fmt.Sprintf(checkExpiresText, tableName)); err != nil {
+		if _, err = db.ExecContext(ctx, fmt.Sprintf(addExpiresText, tableName)); err != nil {
+			return nil, err
+		}
 	}
-	setQuery, err := db.PrepareContext(ctx, fmt.Sprintf(setQueryText, tableName))
-	if err != nil {
-		return nil, err
+
+	p := d.placeholder
+	c := &dbStorageClient{db: db, ttl: ttl, now: time.Now}
+	queries := []struct {
+		stmt **sql.Stmt
+		text string
+	}{
+		{&c.getQuery, fmt.Sprintf(getQueryText, tableName, p(1), p(2))},
+		{&c.setQuery, fmt.Sprintf(setQueryText, tableName, p(1), p(2), p(3))},
+		{&c.deleteQuery, fmt.Sprintf(deleteQueryText, tableName, p(1))},
 	}
-	deleteQuery, err := db.PrepareContext(ctx, fmt.Sprintf(deleteQueryText, tableName))
-	if err != nil {
-		return nil, err
+	for _, q := range queries {
+		if *q.stmt, err = db.PrepareContext(ctx, q.text); err != nil {
+			return nil, multierr.Append(err, c.Close(ctx))
+		}
 	}
-	return &dbStorageClient{db, selectQuery, setQuery, deleteQuery}, nil
+	return c, nil
 }
 
 // Get will retrieve data from storage that corresponds to the specified key
 func (c *dbStorageClient) Get(ctx context.Context, key string) ([]byte, error) {
-	rows, err := c.getQuery.QueryContext(ctx, key)
-	if err != nil {
-		return nil, err
-	}
-	if !rows.Next() {
-		return nil, nil
-	}
-	var result []byte
-	err = rows.Scan(&result)
-	if err != nil {
-		return result, err
-	}
-	err = rows.Close()
-	return result, err
+	return c.get(ctx, c.getQuery, key)
 }
 
 // Set will store data. The data can be retrieved using the same key
 func (c *dbStorageClient) Set(ctx context.Context, key string, value []byte) error {
-	_, err := c.setQuery.ExecContext(ctx, key, value, value)
-	return err
+	return c.set(ctx, c.setQuery, key, value)
 }
 
 // Delete will delete data associated with the specified key
@@ -82,38 +112,61 @@ func (c *dbStorageClient) Delete(ctx context.Context, key string) error {
 	return err
 }
 
-// Batch executes the specified operations in order. Get operation results are updated in place
+// Batch executes the specified operations in order, in a single transaction.
+// Get operation results are updated in place
 func (c *dbStorageClient) Batch(ctx context.Context, ops ...storage.Operation) error {
-	var err error
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	getQuery := tx.StmtContext(ctx, c.getQuery)
+	setQuery := tx.StmtContext(ctx, c.setQuery)
+	deleteQuery := tx.StmtContext(ctx, c.deleteQuery)
 	for _, op := range ops {
 		switch op.Type {
 		case storage.Get:
-			op.Value, err = c.Get(ctx, op.Key)
+			op.Value, err = c.get(ctx, getQuery, op.Key)
 		case storage.Set:
-			err = c.Set(ctx, op.Key, op.Value)
+			err = c.set(ctx, setQuery, op.Key, op.Value)
 		case storage.Delete:
-			err = c.Delete(ctx, op.Key)
+			_, err = deleteQuery.ExecContext(ctx, op.Key)
 		default:
-			return errors.New("wrong operation type")
+			err = errors.New("wrong operation type")
 		}
 
 		if err != nil {
-			return err
+			return multierr.Append(err, tx.Rollback())
 		}
 	}
-	return err
+	return tx.Commit()
 }
 
 // Close will close the database
 func (c *dbStorageClient) Close(_ context.Context) error {
-	if err := c.setQuery.Close(); err != nil {
-		return err
+	var errs error
+	for _, stmt := range []*sql.Stmt{c.setQuery, c.deleteQuery, c.getQuery} {
+		if stmt != nil {
+			errs = multierr.Append(errs, stmt.Close())
+		}
 	}
-	if err := c.deleteQuery.Close(); err != nil {
-		return err
+	return errs
+}
+
+func (c *dbStorageClient) get(ctx context.Context, query *sql.Stmt, key string) ([]byte, error) {
+	var result []byte
+	err := query.QueryRowContext(ctx, key, c.now().UnixNano()).Scan(&result)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
 	}
-	if err := c.getQuery.Close(); err != nil {
-		return err
+	return result, err
+}
+
+func (c *dbStorageClient) set(ctx context.Context, query *sql.Stmt, key string, value []byte) error {
+	var expiresAt sql.NullInt64
+	if c.ttl > 0 {
+		expiresAt = sql.NullInt64{Int64: c.now().Add(c.ttl).UnixNano(), Valid: true}
 	}
-	return nil
+	_, err := query.ExecContext(ctx, key, value, expiresAt)
+	return err
 }
@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Skip tests on Windows temporarily, see https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/11451
+//go:build !windows
+// +build !windows
+
+package dbstorage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+func newTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, db.Close())
+	})
+	return db
+}
+
+func newTestClient(t *testing.T, db *sql.DB, ttl time.Duration) *dbStorageClient {
+	ctx := context.Background()
+	client, err := newClient(ctx, db, sqliteDialect, "receiver_nop_test", ttl)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, client.Close(ctx))
+	})
+	return client
+}
+
+func TestClientTTL(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, newTestDB(t), time.Minute)
+	now := time.Now()
+	client.now = func() time.Time { return now }
+
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	// setting the key again extends its life
+	now = now.Add(50 * time.Second)
+	require.NoError(t, client.Set(ctx, "key", []byte("other")))
+	now = now.Add(50 * time.Second)
+	value, err = client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("other"), value)
+
+	now = now.Add(time.Minute)
+	value, err = client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestClientWithoutTTL(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, newTestDB(t), 0)
+	now := time.Now()
+	client.now = func() time.Time { return now }
+
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	now = now.Add(24 * 365 * time.Hour)
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestClientBatch(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, newTestDB(t), 0)
+	require.NoError(t, client.Set(ctx, "existing", []byte("old")))
+
+	get := storage.GetOperation("existing")
+	err := client.Batch(ctx,
+		get,
+		storage.SetOperation("existing", []byte("new")),
+		storage.SetOperation("other", []byte("value")),
+		storage.DeleteOperation("missing"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("old"), get.Value)
+
+	value, err := client.Get(ctx, "existing")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("new"), value)
+	value, err = client.Get(ctx, "other")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestClientBatchIsRolledBack(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, newTestDB(t), 0)
+
+	invalid := storage.GetOperation("key")
+	invalid.Type = storage.Delete + 1
+	err := client.Batch(ctx, storage.SetOperation("key", []byte("value")), invalid)
+	assert.EqualError(t, err, "wrong operation type")
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestClientMigratesTableWithoutExpiry(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	_, err := db.ExecContext(ctx, "create table receiver_nop_test (key text primary key, value blob)")
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "insert into receiver_nop_test(key, value) values('key', 'value')")
+	require.NoError(t, err)
+
+	client := newTestClient(t, db, time.Minute)
+
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+	require.NoError(t, client.Set(ctx, "key", []byte("other")))
+}
+
+func TestDialectFor(t *testing.T) {
+	assert.Equal(t, "bytea", dialectFor("pgx").blobType)
+	assert.Equal(t, "$2", dialectFor("pgx").placeholder(2))
+	assert.Equal(t, "blob", dialectFor("sqlite3").blobType)
+	assert.Equal(t, "?", dialectFor("sqlite3").placeholder(2))
+}
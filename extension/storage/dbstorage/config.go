@@ -5,12 +5,27 @@ package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-c
 
 import (
 	"errors"
+	"regexp"
+	"time"
 )
 
+var tablePrefixRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 // Config defines configuration for dbstorage extension.
 type Config struct {
 	DriverName string `mapstructure:"driver,omitempty"`
 	DataSource string `mapstructure:"datasource,omitempty"`
+
+	// TablePrefix is prepended to the name of the table of each component, so that
+	// several collectors can share a database.
+	TablePrefix string `mapstructure:"table_prefix,omitempty"`
+
+	// TTL is how long a key is kept after it was last set. Zero means the keys never expire.
+	TTL time.Duration `mapstructure:"ttl,omitempty"`
+
+	// CleanupInterval is how often the expired keys are deleted from the tables.
+	// It's only used when TTL is set.
+	CleanupInterval time.Duration `mapstructure:"cleanup_interval,omitempty"`
 }
 
 func (cfg *Config) Validate() error {
@@ -20,6 +35,15 @@ func (cfg *Config) Validate() error {
 	if cfg.DriverName == "" {
 		return errors.New("missing driver name")
 	}
+	if cfg.TablePrefix != "" && !tablePrefixRegexp.MatchString(cfg.TablePrefix) {
+		return errors.New("table prefix must only contain letters, digits and underscores, and not start with a digit")
+	}
+	if cfg.TTL < 0 {
+		return errors.New("ttl must not be negative")
+	}
+	if cfg.TTL > 0 && cfg.CleanupInterval <= 0 {
+		return errors.New("cleanup interval must be positive when ttl is set")
+	}
 
 	return nil
 }
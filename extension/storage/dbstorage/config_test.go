@@ -10,6 +10,7 @@ package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-c
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -35,6 +36,26 @@ func TestConfigValidate(t *testing.T) {
 			Config{DriverName: "foo", DataSource: "bar"},
 			nil,
 		},
+		{
+			"Invalid table prefix",
+			Config{DriverName: "foo", DataSource: "bar", TablePrefix: "otel-"},
+			errors.New("table prefix must only contain letters, digits and underscores, and not start with a digit"),
+		},
+		{
+			"Negative ttl",
+			Config{DriverName: "foo", DataSource: "bar", TTL: -time.Second},
+			errors.New("ttl must not be negative"),
+		},
+		{
+			"Missing cleanup interval",
+			Config{DriverName: "foo", DataSource: "bar", TTL: time.Hour},
+			errors.New("cleanup interval must be positive when ttl is set"),
+		},
+		{
+			"valid with ttl",
+			Config{DriverName: "foo", DataSource: "bar", TablePrefix: "otel_", TTL: time.Hour, CleanupInterval: time.Minute},
+			nil,
+		},
 	}
 
 	for _, test := range tests {
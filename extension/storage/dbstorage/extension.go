@@ -7,7 +7,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"strings"
+	"regexp"
+	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension"
@@ -15,11 +17,25 @@ import (
 	"go.uber.org/zap"
 )
 
+// invalidTableChars matches the characters that can't be part of an unquoted table name
+var invalidTableChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
 type databaseStorage struct {
-	driverName     string
-	datasourceName string
-	logger         *zap.Logger
-	db             *sql.DB
+	driverName      string
+	datasourceName  string
+	tablePrefix     string
+	ttl             time.Duration
+	cleanupInterval time.Duration
+	dialect         dialect
+	logger          *zap.Logger
+	db              *sql.DB
+
+	// tables holds the names of the tables handed out to the components, to be cleaned up
+	tables     map[string]struct{}
+	tablesLock sync.Mutex
+
+	stopCh     chan struct{}
+	shutdownWg sync.WaitGroup
 }
 
 // Ensure this storage extension implements the appropriate interface
@@ -27,13 +43,19 @@ var _ storage.Extension = (*databaseStorage)(nil)
 
 func newDBStorage(logger *zap.Logger, config *Config) (extension.Extension, error) {
 	return &databaseStorage{
-		driverName:     config.DriverName,
-		datasourceName: config.DataSource,
-		logger:         logger,
+		driverName:      config.DriverName,
+		datasourceName:  config.DataSource,
+		tablePrefix:     config.TablePrefix,
+		ttl:             config.TTL,
+		cleanupInterval: config.CleanupInterval,
+		dialect:         dialectFor(config.DriverName),
+		logger:          logger,
+		tables:          make(map[string]struct{}),
+		stopCh:          make(chan struct{}),
 	}, nil
 }
 
-// Start opens a connection to the database
+// Start opens a connection to the database, and starts deleting the expired keys periodically
 func (ds *databaseStorage) Start(context.Context, component.Host) error {
 	db, err := sql.Open(ds.driverName, ds.datasourceName)
 	if err != nil {
@@ -44,6 +66,11 @@ func (ds *databaseStorage) Start(context.Context, component.Host) error {
 		return err
 	}
 	ds.db = db
+
+	if ds.ttl > 0 {
+		ds.shutdownWg.Add(1)
+		go ds.periodicCleanup()
+	}
 	return nil
 }
 
@@ -52,19 +79,70 @@ func (ds *databaseStorage) Shutdown(context.Context) error {
 	if ds.db == nil {
 		return nil
 	}
+	close(ds.stopCh)
+	ds.shutdownWg.Wait()
 	return ds.db.Close()
 }
 
 // GetClient returns a storage client for an individual component
 func (ds *databaseStorage) GetClient(ctx context.Context, kind component.Kind, ent component.ID, name string) (storage.Client, error) {
+	tableName := ds.tableName(kind, ent, name)
+	client, err := newClient(ctx, ds.db, ds.dialect, tableName, ds.ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	ds.tablesLock.Lock()
+	ds.tables[tableName] = struct{}{}
+	ds.tablesLock.Unlock()
+	return client, nil
+}
+
+// tableName returns the name of the table holding the keys of the given component
+func (ds *databaseStorage) tableName(kind component.Kind, ent component.ID, name string) string {
 	var fullName string
 	if name == "" {
 		fullName = fmt.Sprintf("%s_%s_%s", kindString(kind), ent.Type(), ent.Name())
 	} else {
 		fullName = fmt.Sprintf("%s_%s_%s_%s", kindString(kind), ent.Type(), ent.Name(), name)
 	}
-	fullName = strings.ReplaceAll(fullName, " ", "")
-	return newClient(ctx, ds.db, fullName)
+	return ds.tablePrefix + invalidTableChars.ReplaceAllString(fullName, "")
+}
+
+func (ds *databaseStorage) periodicCleanup() {
+	defer ds.shutdownWg.Done()
+
+	ticker := time.NewTicker(ds.cleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			ds.cleanup(context.Background(), time.Now())
+		case <-ds.stopCh:
+			return
+		}
+	}
+}
+
+// cleanup deletes the keys that expired at the given time from all the tables in use
+func (ds *databaseStorage) cleanup(ctx context.Context, now time.Time) {
+	ds.tablesLock.Lock()
+	tables := make([]string, 0, len(ds.tables))
+	for table := range ds.tables {
+		tables = append(tables, table)
+	}
+	ds.tablesLock.Unlock()
+
+	for _, table := range tables {
+		res, err := ds.db.ExecContext(ctx, fmt.Sprintf(cleanupQueryText, table, ds.dialect.placeholder(1)), now.UnixNano())
+		if err != nil {
+			ds.logger.Warn("failed to delete the expired keys", zap.String("table", table), zap.Error(err))
+			continue
+		}
+		if deleted, err := res.RowsAffected(); err == nil && deleted > 0 {
+			ds.logger.Debug("deleted the expired keys", zap.String("table", table), zap.Int64("count", deleted))
+		}
+	}
 }
 
 func kindString(k component.Kind) string {
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -101,6 +102,51 @@ func TestExtensionIntegrity(t *testing.T) {
 	wg.Wait()
 }
 
+func TestTableName(t *testing.T) {
+	ds := &databaseStorage{tablePrefix: "otel_"}
+
+	assert.Equal(t, "otel_receiver_filelog_", ds.tableName(component.KindReceiver, component.NewID("filelog"), ""))
+	assert.Equal(t, "otel_exporter_otlp_backend1_sending_queue",
+		ds.tableName(component.KindExporter, component.NewIDWithName("otlp", "backend-1"), "sending_queue"))
+}
+
+func TestExtensionCleanup(t *testing.T) {
+	ctx := context.Background()
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.DriverName = "sqlite3"
+	cfg.DataSource = fmt.Sprintf("file:%s/foo.db?_busy_timeout=10000&_journal=WAL&_sync=NORMAL", t.TempDir())
+	cfg.TTL = time.Minute
+	ext, err := f.CreateExtension(ctx, extensiontest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	ds := ext.(*databaseStorage)
+	require.NoError(t, ds.Start(ctx, componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, ds.Shutdown(ctx))
+	}()
+
+	client, err := ds.GetClient(ctx, component.KindReceiver, newTestEntity("receiver_one"), "")
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, client.Close(ctx))
+	}()
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+
+	countRows := func() int {
+		var count int
+		row := ds.db.QueryRowContext(ctx, "select count(*) from receiver_nop_receiver_one")
+		require.NoError(t, row.Scan(&count))
+		return count
+	}
+
+	// not expired yet
+	ds.cleanup(ctx, time.Now())
+	assert.Equal(t, 1, countRows())
+
+	ds.cleanup(ctx, time.Now().Add(2*time.Minute))
+	assert.Equal(t, 0, countRows())
+}
+
 func newTestExtension(t *testing.T) storage.Extension {
 	f := NewFactory()
 	cfg := f.CreateDefaultConfig().(*Config)
@@ -5,13 +5,18 @@ package dbstorage // import "github.com/open-telemetry/opentelemetry-collector-c
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension"
 )
 
-// The value of extension "type" in configuration.
-const typeStr component.Type = "db_storage"
+const (
+	// The value of extension "type" in configuration.
+	typeStr component.Type = "db_storage"
+
+	defaultCleanupInterval = time.Minute
+)
 
 // NewFactory creates a factory for DBStorage extension.
 func NewFactory() extension.Factory {
@@ -24,7 +29,9 @@ func NewFactory() extension.Factory {
 }
 
 func createDefaultConfig() component.Config {
-	return &Config{}
+	return &Config{
+		CleanupInterval: defaultCleanupInterval,
+	}
 }
 
 func createExtension(
@@ -15,6 +15,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 )
 
@@ -60,6 +61,27 @@ func NewBoolExprForSpanEvent(conditions []string, functions map[string]ottl.Fact
 	return &s, nil
 }
 
+// NewBoolExprForSpanLink creates a BoolExpr[ottlspanlink.TransformContext] that will return true if any of the given OTTL conditions evaluate to true.
+// The passed in functions should use the ottlspanlink.TransformContext.
+// If a function named `drop` is not present in the function map it will be added automatically so that parsing works as expected
+func NewBoolExprForSpanLink(conditions []string, functions map[string]ottl.Factory[ottlspanlink.TransformContext], errorMode ottl.ErrorMode, set component.TelemetrySettings) (expr.BoolExpr[ottlspanlink.TransformContext], error) {
+	drop := newDropFactory[ottlspanlink.TransformContext]()
+	if _, ok := functions[drop.Name()]; !ok {
+		functions[drop.Name()] = drop
+	}
+	statmentsStr := conditionsToStatements(conditions)
+	parser, err := ottlspanlink.NewParser(functions, set)
+	if err != nil {
+		return nil, err
+	}
+	statements, err := parser.ParseStatements(statmentsStr)
+	if err != nil {
+		return nil, err
+	}
+	s := ottlspanlink.NewStatements(statements, set, ottlspanlink.WithErrorMode(errorMode))
+	return &s, nil
+}
+
 // NewBoolExprForMetric creates a BoolExpr[ottlmetric.TransformContext] that will return true if any of the given OTTL conditions evaluate to true.
 // The passed in functions should use the ottlmetric.TransformContext.
 // If a function named `drop` is not present in the function map it will be added automatically so that parsing works as expected
@@ -139,6 +161,10 @@ func StandardSpanEventFuncs() map[string]ottl.Factory[ottlspanevent.TransformCon
 	return standardFuncs[ottlspanevent.TransformContext]()
 }
 
+func StandardSpanLinkFuncs() map[string]ottl.Factory[ottlspanlink.TransformContext] {
+	return standardFuncs[ottlspanlink.TransformContext]()
+}
+
 func StandardMetricFuncs() map[string]ottl.Factory[ottlmetric.TransformContext] {
 	return standardFuncs[ottlmetric.TransformContext]()
 }
@@ -164,6 +190,20 @@ func standardFuncs[K any]() map[string]ottl.Factory[K] {
 		ottlfuncs.NewLogFactory[K](),
 		ottlfuncs.NewUUIDFactory[K](),
 		ottlfuncs.NewParseJSONFactory[K](),
+		ottlfuncs.NewParseKeyValueFactory[K](),
+		ottlfuncs.NewExtractPatternsFactory[K](),
+		ottlfuncs.NewParseCSVFactory[K](),
+		ottlfuncs.NewParseXMLFactory[K](),
+		ottlfuncs.NewURLFactory[K](),
+		ottlfuncs.NewTimeFactory[K](),
+		ottlfuncs.NewFormatTimeFactory[K](),
+		ottlfuncs.NewNowFactory[K](),
+		ottlfuncs.NewDurationFactory[K](),
+		ottlfuncs.NewUnixFactory[K](),
+		ottlfuncs.NewUnixSecondsFactory[K](),
+		ottlfuncs.NewUnixMilliFactory[K](),
+		ottlfuncs.NewUnixMicroFactory[K](),
+		ottlfuncs.NewUnixNanoFactory[K](),
 		newDropFactory[K](),
 	)
 }
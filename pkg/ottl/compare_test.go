@@ -6,11 +6,12 @@ package ottl
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"go.opentelemetry.io/collector/component/componenttest"
 )
 
-// Our types are bool, int, float, string, Bytes, nil, so we compare all types in both directions.
+// Our types are bool, int, float, string, Bytes, time, duration, nil, so we compare all types in both directions.
 var (
 	ta   = false
 	tb   = true
@@ -24,6 +25,10 @@ var (
 	i64b = int64(2)
 	f64a = float64(1)
 	f64b = float64(2)
+	tma  = time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	tmb  = time.Date(2023, 5, 1, 12, 0, 0, 0, time.FixedZone("", 3600))
+	da   = time.Second
+	db   = time.Minute
 )
 
 type testA struct {
@@ -95,6 +100,20 @@ func Test_compare(t *testing.T) {
 		{"float64 nil", f64a, nil, []bool{false, true, false, false, false, false}},
 		{"float64 int64", f64a, i64b, []bool{false, true, true, true, false, false}},
 
+		{"identity time", tma, tma, []bool{true, false, false, true, true, false}},
+		{"diff times", tma, tmb, []bool{false, true, true, true, false, false}},
+		{"same instant in different locations", tmb, tmb.UTC(), []bool{true, false, false, true, true, false}},
+		{"time int64", tma, i64a, []bool{false, true, false, false, false, false}},
+		{"time string", tma, sa, []bool{false, true, false, false, false, false}},
+		{"time nil", tma, nil, []bool{false, true, false, false, false, false}},
+		{"time duration", tma, da, []bool{false, true, false, false, false, false}},
+
+		{"identity duration", da, da, []bool{true, false, false, true, true, false}},
+		{"diff durations", da, db, []bool{false, true, true, true, false, false}},
+		{"duration int64", da, i64a, []bool{false, true, false, false, false, false}},
+		{"duration time", da, tma, []bool{false, true, false, false, false, false}},
+		{"duration nil", da, nil, []bool{false, true, false, false, false, false}},
+
 		{"non-prim, same type, equal", testA{"hi"}, testA{"hi"}, []bool{true, false, false, false, false, false}},
 		{"non-prim, same type, not equal", testA{"hi"}, testA{"byte"}, []bool{false, true, false, false, false, false}},
 		{"non-prim, diff type", testA{"hi"}, testB{"hi"}, []bool{false, true, false, false, false, false}},
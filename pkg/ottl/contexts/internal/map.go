@@ -12,6 +12,10 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/ottlcommon"
 )
 
+// maxNewSliceIndex is the largest index allowed when indexing into an empty
+// value, which creates a slice padded with empty values up to that index.
+const maxNewSliceIndex = 1024
+
 func GetMapValue(m pcommon.Map, keys []ottl.Key) (interface{}, error) {
 	if len(keys) == 0 {
 		return nil, fmt.Errorf("cannot get map value without key")
@@ -24,7 +28,14 @@ func GetMapValue(m pcommon.Map, keys []ottl.Key) (interface{}, error) {
 	if !ok {
 		return nil, nil
 	}
-	for i := 1; i < len(keys); i++ {
+	return GetIndexableValue(val, keys[1:])
+}
+
+// GetIndexableValue returns the value found by indexing into val with each of
+// the keys in turn. Maps must be indexed by strings and slices by ints.
+func GetIndexableValue(val pcommon.Value, keys []ottl.Key) (interface{}, error) {
+	var ok bool
+	for i := 0; i < len(keys); i++ {
 		switch val.Type() {
 		case pcommon.ValueTypeMap:
 			if keys[i].String == nil {
@@ -57,6 +68,17 @@ func SetMapValue(m pcommon.Map, keys []ottl.Key, val interface{}) error {
 		return fmt.Errorf("non-string indexing is not supported")
 	}
 
+	currentValue, ok := m.Get(*keys[0].String)
+	if !ok {
+		currentValue = m.PutEmpty(*keys[0].String)
+	}
+	return SetIndexableValue(currentValue, keys[1:], val)
+}
+
+// SetIndexableValue sets val at the location found by indexing into
+// currentValue with each of the keys in turn. Empty values along the way are
+// turned into maps or slices depending on the type of the key.
+func SetIndexableValue(currentValue pcommon.Value, keys []ottl.Key, val interface{}) error {
 	var newValue pcommon.Value
 	switch val.(type) {
 	case []string, []bool, []int64, []float64, [][]byte, []any:
@@ -69,12 +91,7 @@ func SetMapValue(m pcommon.Map, keys []ottl.Key, val interface{}) error {
 		return err
 	}
 
-	currentValue, ok := m.Get(*keys[0].String)
-	if !ok {
-		currentValue = m.PutEmpty(*keys[0].String)
-	}
-
-	for i := 1; i < len(keys); i++ {
+	for i := 0; i < len(keys); i++ {
 		switch currentValue.Type() {
 		case pcommon.ValueTypeMap:
 			if keys[i].String == nil {
@@ -98,6 +115,9 @@ func SetMapValue(m pcommon.Map, keys []ottl.Key, val interface{}) error {
 			if keys[i].String != nil {
 				currentValue = currentValue.SetEmptyMap().PutEmpty(*keys[i].String)
 			} else if keys[i].Int != nil {
+				if *keys[i].Int < 0 || *keys[i].Int > maxNewSliceIndex {
+					return fmt.Errorf("index %v out of bounds", *keys[i].Int)
+				}
 				currentValue.SetEmptySlice()
 				for k := 0; k < int(*keys[i].Int); k++ {
 					currentValue.Slice().AppendEmpty()
@@ -270,3 +270,79 @@ func Test_SetMapValue_EmptyMap(t *testing.T) {
 
 	assert.Equal(t, expected, m)
 }
+
+func Test_GetIndexableValue(t *testing.T) {
+	val := pcommon.NewValueSlice()
+	val.Slice().AppendEmpty().SetStr("first")
+	val.Slice().AppendEmpty().SetEmptyMap().PutStr("foo", "bar")
+
+	result, err := GetIndexableValue(val, []ottl.Key{
+		{
+			Int: ottltest.Intp(1),
+		},
+		{
+			String: ottltest.Strp("foo"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", result)
+
+	result, err = GetIndexableValue(val, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, val.Slice(), result)
+
+	_, err = GetIndexableValue(pcommon.NewValueStr("test"), []ottl.Key{
+		{
+			Int: ottltest.Intp(0),
+		},
+	})
+	assert.Equal(t, fmt.Errorf("type Str does not support string indexing"), err)
+}
+
+func Test_SetIndexableValue_EmptyValue(t *testing.T) {
+	val := pcommon.NewValueEmpty()
+	keys := []ottl.Key{
+		{
+			Int: ottltest.Intp(1),
+		},
+		{
+			String: ottltest.Strp("foo"),
+		},
+	}
+	err := SetIndexableValue(val, keys, "bar")
+	assert.Nil(t, err)
+
+	expected := pcommon.NewValueSlice()
+	expected.Slice().AppendEmpty()
+	expected.Slice().AppendEmpty().SetEmptyMap().PutStr("foo", "bar")
+
+	assert.Equal(t, expected, val)
+}
+
+func Test_SetIndexableValue_EmptyValue_OutOfBounds(t *testing.T) {
+	tests := []struct {
+		name  string
+		index int64
+	}{
+		{
+			name:  "negative index",
+			index: -1,
+		},
+		{
+			name:  "index too large",
+			index: maxNewSliceIndex + 1,
+		},
+		{
+			name:  "huge index",
+			index: 1 << 40,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val := pcommon.NewValueEmpty()
+			err := SetIndexableValue(val, []ottl.Key{{Int: ottltest.Intp(tt.index)}}, "bar")
+			assert.Error(t, err)
+			assert.Equal(t, pcommon.ValueTypeEmpty, val.Type())
+		})
+	}
+}
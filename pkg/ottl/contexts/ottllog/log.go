@@ -148,11 +148,18 @@ func newPathGetSetter(path []ottl.Field) (ottl.GetSetter[TransformContext], erro
 		return accessTimeUnixNano(), nil
 	case "observed_time_unix_nano":
 		return accessObservedTimeUnixNano(), nil
+	case "time":
+		return accessTime(), nil
+	case "observed_time":
+		return accessObservedTime(), nil
 	case "severity_number":
 		return accessSeverityNumber(), nil
 	case "severity_text":
 		return accessSeverityText(), nil
 	case "body":
+		if keys := path[0].Keys; keys != nil {
+			return accessBodyKey(keys), nil
+		}
 		return accessBody(), nil
 	case "attributes":
 		mapKey := path[0].Keys
@@ -236,6 +243,34 @@ func accessObservedTimeUnixNano() ottl.StandardGetSetter[TransformContext] {
 	}
 }
 
+func accessTime() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			return tCtx.GetLogRecord().Timestamp().AsTime(), nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			if t, ok := val.(time.Time); ok {
+				tCtx.GetLogRecord().SetTimestamp(pcommon.NewTimestampFromTime(t))
+			}
+			return nil
+		},
+	}
+}
+
+func accessObservedTime() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			return tCtx.GetLogRecord().ObservedTimestamp().AsTime(), nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			if t, ok := val.(time.Time); ok {
+				tCtx.GetLogRecord().SetObservedTimestamp(pcommon.NewTimestampFromTime(t))
+			}
+			return nil
+		},
+	}
+}
+
 func accessSeverityNumber() ottl.StandardGetSetter[TransformContext] {
 	return ottl.StandardGetSetter[TransformContext]{
 		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
@@ -275,6 +310,17 @@ func accessBody() ottl.StandardGetSetter[TransformContext] {
 	}
 }
 
+func accessBodyKey(keys []ottl.Key) ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			return internal.GetIndexableValue(tCtx.GetLogRecord().Body(), keys)
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			return internal.SetIndexableValue(tCtx.GetLogRecord().Body(), keys, val)
+		},
+	}
+}
+
 func accessAttributes() ottl.StandardGetSetter[TransformContext] {
 	return ottl.StandardGetSetter[TransformContext]{
 		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
@@ -75,6 +75,32 @@ func Test_newPathGetSetter(t *testing.T) {
 				log.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(200)))
 			},
 		},
+		{
+			name: "time",
+			path: []ottl.Field{
+				{
+					Name: "time",
+				},
+			},
+			orig:   time.Date(1970, 1, 1, 0, 0, 0, 100000000, time.UTC),
+			newVal: time.Date(1970, 1, 1, 0, 0, 0, 200000000, time.UTC),
+			modified: func(log plog.LogRecord, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				log.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(200)))
+			},
+		},
+		{
+			name: "observed_time",
+			path: []ottl.Field{
+				{
+					Name: "observed_time",
+				},
+			},
+			orig:   time.Date(1970, 1, 1, 0, 0, 0, 500000000, time.UTC),
+			newVal: time.Date(1970, 1, 1, 0, 0, 0, 200000000, time.UTC),
+			modified: func(log plog.LogRecord, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				log.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(200)))
+			},
+		},
 		{
 			name: "severity_number",
 			path: []ottl.Field{
@@ -595,6 +621,126 @@ func Test_newPathGetSetter(t *testing.T) {
 	}
 }
 
+func Test_newPathGetSetter_BodyKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []ottl.Key
+		body     func(body pcommon.Value)
+		orig     interface{}
+		newVal   interface{}
+		modified func(body pcommon.Value)
+	}{
+		{
+			name: "map body",
+			keys: []ottl.Key{
+				{
+					String: ottltest.Strp("foo"),
+				},
+			},
+			body: func(body pcommon.Value) {
+				body.SetEmptyMap().PutStr("foo", "bar")
+			},
+			orig:   "bar",
+			newVal: "new",
+			modified: func(body pcommon.Value) {
+				body.Map().PutStr("foo", "new")
+			},
+		},
+		{
+			name: "slice body",
+			keys: []ottl.Key{
+				{
+					Int: ottltest.Intp(1),
+				},
+				{
+					String: ottltest.Strp("foo"),
+				},
+			},
+			body: func(body pcommon.Value) {
+				s := body.SetEmptySlice()
+				s.AppendEmpty().SetStr("first")
+				s.AppendEmpty().SetEmptyMap().PutStr("foo", "bar")
+			},
+			orig:   "bar",
+			newVal: int64(1),
+			modified: func(body pcommon.Value) {
+				body.Slice().At(1).Map().PutInt("foo", 1)
+			},
+		},
+		{
+			name: "missing map key",
+			keys: []ottl.Key{
+				{
+					String: ottltest.Strp("missing"),
+				},
+			},
+			body: func(body pcommon.Value) {
+				body.SetEmptyMap()
+			},
+			orig:   nil,
+			newVal: "new",
+			modified: func(body pcommon.Value) {
+				body.Map().PutStr("missing", "new")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := newPathGetSetter([]ottl.Field{
+				{
+					Name: "body",
+					Keys: tt.keys,
+				},
+			})
+			assert.NoError(t, err)
+
+			log, il, resource := createTelemetry()
+			tt.body(log.Body())
+
+			tCtx := NewTransformContext(log, il, resource)
+			got, err := accessor.Get(context.Background(), tCtx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.orig, got)
+
+			err = accessor.Set(context.Background(), tCtx, tt.newVal)
+			assert.NoError(t, err)
+
+			exLog, _, _ := createTelemetry()
+			tt.body(exLog.Body())
+			tt.modified(exLog.Body())
+
+			assert.Equal(t, exLog, log)
+		})
+	}
+}
+
+func Test_newPathGetSetter_BodyKey_Empty(t *testing.T) {
+	accessor, err := newPathGetSetter([]ottl.Field{
+		{
+			Name: "body",
+			Keys: []ottl.Key{
+				{
+					String: ottltest.Strp("foo"),
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	log := plog.NewLogRecord()
+	tCtx := NewTransformContext(log, pcommon.NewInstrumentationScope(), pcommon.NewResource())
+	err = accessor.Set(context.Background(), tCtx, "bar")
+	assert.NoError(t, err)
+
+	expected := plog.NewLogRecord()
+	expected.Body().SetEmptyMap().PutStr("foo", "bar")
+	assert.Equal(t, expected, log)
+
+	log.Body().SetStr("string")
+	_, err = accessor.Get(context.Background(), tCtx)
+	assert.Error(t, err)
+}
+
 func createTelemetry() (plog.LogRecord, pcommon.InstrumentationScope, pcommon.Resource) {
 	log := plog.NewLogRecord()
 	log.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(100)))
@@ -125,6 +125,8 @@ func newPathGetSetter(path []ottl.Field) (ottl.GetSetter[TransformContext], erro
 		return internal.SpanPathGetSetter[TransformContext](path[1:])
 	case "time_unix_nano":
 		return accessSpanEventTimeUnixNano(), nil
+	case "time":
+		return accessSpanEventTime(), nil
 	case "name":
 		return accessSpanEventName(), nil
 	case "attributes":
@@ -179,6 +181,20 @@ func accessSpanEventTimeUnixNano() ottl.StandardGetSetter[TransformContext] {
 	}
 }
 
+func accessSpanEventTime() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			return tCtx.GetSpanEvent().Timestamp().AsTime(), nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			if newTime, ok := val.(time.Time); ok {
+				tCtx.GetSpanEvent().SetTimestamp(pcommon.NewTimestampFromTime(newTime))
+			}
+			return nil
+		},
+	}
+}
+
 func accessSpanEventName() ottl.StandardGetSetter[TransformContext] {
 	return ottl.StandardGetSetter[TransformContext]{
 		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
@@ -0,0 +1,329 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlspanlink // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal"
+)
+
+var _ internal.ResourceContext = TransformContext{}
+var _ internal.InstrumentationScopeContext = TransformContext{}
+var _ internal.SpanContext = TransformContext{}
+
+type TransformContext struct {
+	spanLink             ptrace.SpanLink
+	span                 ptrace.Span
+	instrumentationScope pcommon.InstrumentationScope
+	resource             pcommon.Resource
+	cache                pcommon.Map
+}
+
+type Option func(*ottl.Parser[TransformContext])
+
+func NewTransformContext(spanLink ptrace.SpanLink, span ptrace.Span, instrumentationScope pcommon.InstrumentationScope, resource pcommon.Resource) TransformContext {
+	return TransformContext{
+		spanLink:             spanLink,
+		span:                 span,
+		instrumentationScope: instrumentationScope,
+		resource:             resource,
+		cache:                pcommon.NewMap(),
+	}
+}
+
+func (tCtx TransformContext) GetSpanLink() ptrace.SpanLink {
+	return tCtx.spanLink
+}
+
+func (tCtx TransformContext) GetSpan() ptrace.Span {
+	return tCtx.span
+}
+
+func (tCtx TransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
+	return tCtx.instrumentationScope
+}
+
+func (tCtx TransformContext) GetResource() pcommon.Resource {
+	return tCtx.resource
+}
+
+func (tCtx TransformContext) getCache() pcommon.Map {
+	return tCtx.cache
+}
+
+func NewParser(functions map[string]ottl.Factory[TransformContext], telemetrySettings component.TelemetrySettings, options ...Option) (ottl.Parser[TransformContext], error) {
+	p, err := ottl.NewParser[TransformContext](
+		functions,
+		parsePath,
+		telemetrySettings,
+		ottl.WithEnumParser[TransformContext](parseEnum),
+	)
+	if err != nil {
+		return ottl.Parser[TransformContext]{}, err
+	}
+	for _, opt := range options {
+		opt(&p)
+	}
+	return p, nil
+}
+
+type StatementsOption func(*ottl.Statements[TransformContext])
+
+func WithErrorMode(errorMode ottl.ErrorMode) StatementsOption {
+	return func(s *ottl.Statements[TransformContext]) {
+		ottl.WithErrorMode[TransformContext](errorMode)(s)
+	}
+}
+
+func NewStatements(statements []*ottl.Statement[TransformContext], telemetrySettings component.TelemetrySettings, options ...StatementsOption) ottl.Statements[TransformContext] {
+	s := ottl.NewStatements(statements, telemetrySettings)
+	for _, op := range options {
+		op(&s)
+	}
+	return s
+}
+
+func parseEnum(val *ottl.EnumSymbol) (*ottl.Enum, error) {
+	if val != nil {
+		if enum, ok := internal.SpanSymbolTable[*val]; ok {
+			return &enum, nil
+		}
+		return nil, fmt.Errorf("enum symbol, %s, not found", *val)
+	}
+	return nil, fmt.Errorf("enum symbol not provided")
+}
+
+func parsePath(val *ottl.Path) (ottl.GetSetter[TransformContext], error) {
+	if val != nil && len(val.Fields) > 0 {
+		return newPathGetSetter(val.Fields)
+	}
+	return nil, fmt.Errorf("bad path %v", val)
+}
+
+func newPathGetSetter(path []ottl.Field) (ottl.GetSetter[TransformContext], error) {
+	switch path[0].Name {
+	case "cache":
+		mapKey := path[0].Keys
+		if mapKey == nil {
+			return accessCache(), nil
+		}
+		return accessCacheKey(mapKey), nil
+	case "resource":
+		return internal.ResourcePathGetSetter[TransformContext](path[1:])
+	case "instrumentation_scope":
+		return internal.ScopePathGetSetter[TransformContext](path[1:])
+	case "span":
+		return internal.SpanPathGetSetter[TransformContext](path[1:])
+	case "trace_id":
+		if len(path) == 1 {
+			return accessSpanLinkTraceID(), nil
+		}
+		if path[1].Name == "string" {
+			return accessSpanLinkStringTraceID(), nil
+		}
+	case "span_id":
+		if len(path) == 1 {
+			return accessSpanLinkSpanID(), nil
+		}
+		if path[1].Name == "string" {
+			return accessSpanLinkStringSpanID(), nil
+		}
+	case "trace_state":
+		mapKey := path[0].Keys
+		if mapKey == nil {
+			return accessSpanLinkTraceState(), nil
+		}
+		return accessSpanLinkTraceStateKey(mapKey)
+	case "attributes":
+		mapKey := path[0].Keys
+		if mapKey == nil {
+			return accessSpanLinkAttributes(), nil
+		}
+		return accessSpanLinkAttributesKey(mapKey), nil
+	case "dropped_attributes_count":
+		return accessSpanLinkDroppedAttributeCount(), nil
+	}
+
+	return nil, fmt.Errorf("invalid span link path expression %v", path)
+}
+
+func accessCache() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			return tCtx.getCache(), nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			if m, ok := val.(pcommon.Map); ok {
+				m.CopyTo(tCtx.getCache())
+			}
+			return nil
+		},
+	}
+}
+
+func accessCacheKey(keys []ottl.Key) ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			return internal.GetMapValue(tCtx.getCache(), keys)
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			return internal.SetMapValue(tCtx.getCache(), keys, val)
+		},
+	}
+}
+
+func accessSpanLinkTraceID() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			return tCtx.GetSpanLink().TraceID(), nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			if newTraceID, ok := val.(pcommon.TraceID); ok {
+				tCtx.GetSpanLink().SetTraceID(newTraceID)
+			}
+			return nil
+		},
+	}
+}
+
+func accessSpanLinkStringTraceID() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			id := tCtx.GetSpanLink().TraceID()
+			return hex.EncodeToString(id[:]), nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			if str, ok := val.(string); ok {
+				id, err := internal.ParseTraceID(str)
+				if err != nil {
+					return err
+				}
+				tCtx.GetSpanLink().SetTraceID(id)
+			}
+			return nil
+		},
+	}
+}
+
+func accessSpanLinkSpanID() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			return tCtx.GetSpanLink().SpanID(), nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			if newSpanID, ok := val.(pcommon.SpanID); ok {
+				tCtx.GetSpanLink().SetSpanID(newSpanID)
+			}
+			return nil
+		},
+	}
+}
+
+func accessSpanLinkStringSpanID() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			id := tCtx.GetSpanLink().SpanID()
+			return hex.EncodeToString(id[:]), nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			if str, ok := val.(string); ok {
+				id, err := internal.ParseSpanID(str)
+				if err != nil {
+					return err
+				}
+				tCtx.GetSpanLink().SetSpanID(id)
+			}
+			return nil
+		},
+	}
+}
+
+func accessSpanLinkTraceState() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			return tCtx.GetSpanLink().TraceState().AsRaw(), nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			if str, ok := val.(string); ok {
+				tCtx.GetSpanLink().TraceState().FromRaw(str)
+			}
+			return nil
+		},
+	}
+}
+
+func accessSpanLinkTraceStateKey(keys []ottl.Key) (ottl.StandardGetSetter[TransformContext], error) {
+	if len(keys) != 1 {
+		return ottl.StandardGetSetter[TransformContext]{}, fmt.Errorf("must provide exactly 1 key when accessing trace_state")
+	}
+	if keys[0].String == nil {
+		return ottl.StandardGetSetter[TransformContext]{}, fmt.Errorf("trace_state indexing type must be a string")
+	}
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			if ts, err := trace.ParseTraceState(tCtx.GetSpanLink().TraceState().AsRaw()); err == nil {
+				return ts.Get(*keys[0].String), nil
+			}
+			return nil, nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			if str, ok := val.(string); ok {
+				if ts, err := trace.ParseTraceState(tCtx.GetSpanLink().TraceState().AsRaw()); err == nil {
+					if updated, err := ts.Insert(*keys[0].String, str); err == nil {
+						tCtx.GetSpanLink().TraceState().FromRaw(updated.String())
+					}
+				}
+			}
+			return nil
+		},
+	}, nil
+}
+
+func accessSpanLinkAttributes() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			return tCtx.GetSpanLink().Attributes(), nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			if attrs, ok := val.(pcommon.Map); ok {
+				attrs.CopyTo(tCtx.GetSpanLink().Attributes())
+			}
+			return nil
+		},
+	}
+}
+
+func accessSpanLinkAttributesKey(keys []ottl.Key) ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			return internal.GetMapValue(tCtx.GetSpanLink().Attributes(), keys)
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			return internal.SetMapValue(tCtx.GetSpanLink().Attributes(), keys, val)
+		},
+	}
+}
+
+func accessSpanLinkDroppedAttributeCount() ottl.StandardGetSetter[TransformContext] {
+	return ottl.StandardGetSetter[TransformContext]{
+		Getter: func(ctx context.Context, tCtx TransformContext) (interface{}, error) {
+			return int64(tCtx.GetSpanLink().DroppedAttributesCount()), nil
+		},
+		Setter: func(ctx context.Context, tCtx TransformContext, val interface{}) error {
+			if newCount, ok := val.(int64); ok {
+				tCtx.GetSpanLink().SetDroppedAttributesCount(uint32(newCount))
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,483 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlspanlink
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+var (
+	traceID  = [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	traceID2 = [16]byte{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+	spanID   = [8]byte{1, 2, 3, 4, 5, 6, 7, 8}
+	spanID2  = [8]byte{8, 7, 6, 5, 4, 3, 2, 1}
+)
+
+func Test_newPathGetSetter(t *testing.T) {
+	refSpanLink, refSpan, refIS, refResource := createTelemetry()
+
+	newAttrs := pcommon.NewMap()
+	newAttrs.PutStr("hello", "world")
+
+	newCache := pcommon.NewMap()
+	newCache.PutStr("temp", "value")
+
+	tests := []struct {
+		name     string
+		path     []ottl.Field
+		orig     interface{}
+		newVal   interface{}
+		modified func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map)
+	}{
+		{
+			name: "cache",
+			path: []ottl.Field{
+				{
+					Name: "cache",
+				},
+			},
+			orig:   pcommon.NewMap(),
+			newVal: newCache,
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				newCache.CopyTo(cache)
+			},
+		},
+		{
+			name: "cache access",
+			path: []ottl.Field{
+				{
+					Name: "cache",
+					Keys: []ottl.Key{
+						{
+							String: ottltest.Strp("temp"),
+						},
+					},
+				},
+			},
+			orig:   nil,
+			newVal: "new value",
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				cache.PutStr("temp", "new value")
+			},
+		},
+		{
+			name: "trace_id",
+			path: []ottl.Field{
+				{
+					Name: "trace_id",
+				},
+			},
+			orig:   pcommon.TraceID(traceID),
+			newVal: pcommon.TraceID(traceID2),
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				spanLink.SetTraceID(traceID2)
+			},
+		},
+		{
+			name: "trace_id string",
+			path: []ottl.Field{
+				{
+					Name: "trace_id",
+				},
+				{
+					Name: "string",
+				},
+			},
+			orig:   hex.EncodeToString(traceID[:]),
+			newVal: hex.EncodeToString(traceID2[:]),
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				spanLink.SetTraceID(traceID2)
+			},
+		},
+		{
+			name: "span_id",
+			path: []ottl.Field{
+				{
+					Name: "span_id",
+				},
+			},
+			orig:   pcommon.SpanID(spanID),
+			newVal: pcommon.SpanID(spanID2),
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				spanLink.SetSpanID(spanID2)
+			},
+		},
+		{
+			name: "span_id string",
+			path: []ottl.Field{
+				{
+					Name: "span_id",
+				},
+				{
+					Name: "string",
+				},
+			},
+			orig:   hex.EncodeToString(spanID[:]),
+			newVal: hex.EncodeToString(spanID2[:]),
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				spanLink.SetSpanID(spanID2)
+			},
+		},
+		{
+			name: "trace_state",
+			path: []ottl.Field{
+				{
+					Name: "trace_state",
+				},
+			},
+			orig:   "key1=val1,key2=val2",
+			newVal: "key=newVal",
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				spanLink.TraceState().FromRaw("key=newVal")
+			},
+		},
+		{
+			name: "trace_state key",
+			path: []ottl.Field{
+				{
+					Name: "trace_state",
+					Keys: []ottl.Key{
+						{
+							String: ottltest.Strp("key1"),
+						},
+					},
+				},
+			},
+			orig:   "val1",
+			newVal: "newVal",
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				spanLink.TraceState().FromRaw("key1=newVal,key2=val2")
+			},
+		},
+		{
+			name: "attributes",
+			path: []ottl.Field{
+				{
+					Name: "attributes",
+				},
+			},
+			orig:   refSpanLink.Attributes(),
+			newVal: newAttrs,
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				newAttrs.CopyTo(spanLink.Attributes())
+			},
+		},
+		{
+			name: "attributes string",
+			path: []ottl.Field{
+				{
+					Name: "attributes",
+					Keys: []ottl.Key{
+						{
+							String: ottltest.Strp("str"),
+						},
+					},
+				},
+			},
+			orig:   "val",
+			newVal: "newVal",
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				spanLink.Attributes().PutStr("str", "newVal")
+			},
+		},
+		{
+			name: "attributes int",
+			path: []ottl.Field{
+				{
+					Name: "attributes",
+					Keys: []ottl.Key{
+						{
+							String: ottltest.Strp("int"),
+						},
+					},
+				},
+			},
+			orig:   int64(10),
+			newVal: int64(20),
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				spanLink.Attributes().PutInt("int", 20)
+			},
+		},
+		{
+			name: "attributes nested",
+			path: []ottl.Field{
+				{
+					Name: "attributes",
+					Keys: []ottl.Key{
+						{
+							String: ottltest.Strp("slice"),
+						},
+						{
+							Int: ottltest.Intp(0),
+						},
+						{
+							String: ottltest.Strp("map"),
+						},
+					},
+				},
+			},
+			orig:   "pass",
+			newVal: "new",
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				spanLink.Attributes().PutEmptySlice("slice").AppendEmpty().SetEmptyMap().PutStr("map", "new")
+			},
+		},
+		{
+			name: "dropped_attributes_count",
+			path: []ottl.Field{
+				{
+					Name: "dropped_attributes_count",
+				},
+			},
+			orig:   int64(10),
+			newVal: int64(20),
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				spanLink.SetDroppedAttributesCount(20)
+			},
+		},
+		{
+			name: "instrumentation_scope",
+			path: []ottl.Field{
+				{
+					Name: "instrumentation_scope",
+				},
+			},
+			orig:   refIS,
+			newVal: pcommon.NewInstrumentationScope(),
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				pcommon.NewInstrumentationScope().CopyTo(il)
+			},
+		},
+		{
+			name: "resource",
+			path: []ottl.Field{
+				{
+					Name: "resource",
+				},
+			},
+			orig:   refResource,
+			newVal: pcommon.NewResource(),
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				pcommon.NewResource().CopyTo(resource)
+			},
+		},
+		{
+			name: "span",
+			path: []ottl.Field{
+				{
+					Name: "span",
+				},
+			},
+			orig:   refSpan,
+			newVal: ptrace.NewSpan(),
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				ptrace.NewSpan().CopyTo(span)
+			},
+		},
+		{
+			name: "span name",
+			path: []ottl.Field{
+				{
+					Name: "span",
+				},
+				{
+					Name: "name",
+				},
+			},
+			orig:   "test",
+			newVal: "new",
+			modified: func(spanLink ptrace.SpanLink, span ptrace.Span, il pcommon.InstrumentationScope, resource pcommon.Resource, cache pcommon.Map) {
+				span.SetName("new")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := newPathGetSetter(tt.path)
+			assert.NoError(t, err)
+
+			spanLink, span, il, resource := createTelemetry()
+
+			tCtx := NewTransformContext(spanLink, span, il, resource)
+
+			got, err := accessor.Get(context.Background(), tCtx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.orig, got)
+
+			err = accessor.Set(context.Background(), tCtx, tt.newVal)
+			assert.NoError(t, err)
+
+			exSpanLink, exSpan, exIl, exRes := createTelemetry()
+			exCache := pcommon.NewMap()
+			tt.modified(exSpanLink, exSpan, exIl, exRes, exCache)
+
+			assert.Equal(t, exSpanLink, spanLink)
+			assert.Equal(t, exSpan, span)
+			assert.Equal(t, exIl, il)
+			assert.Equal(t, exRes, resource)
+			assert.Equal(t, exCache, tCtx.getCache())
+		})
+	}
+}
+
+func Test_newPathGetSetter_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		path []ottl.Field
+	}{
+		{
+			name: "unknown field",
+			path: []ottl.Field{
+				{
+					Name: "name",
+				},
+			},
+		},
+		{
+			name: "trace_state with multiple keys",
+			path: []ottl.Field{
+				{
+					Name: "trace_state",
+					Keys: []ottl.Key{
+						{
+							String: ottltest.Strp("key1"),
+						},
+						{
+							String: ottltest.Strp("key2"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "trace_state with int key",
+			path: []ottl.Field{
+				{
+					Name: "trace_state",
+					Keys: []ottl.Key{
+						{
+							Int: ottltest.Intp(0),
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newPathGetSetter(tt.path)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func createTelemetry() (ptrace.SpanLink, ptrace.Span, pcommon.InstrumentationScope, pcommon.Resource) {
+	spanLink := ptrace.NewSpanLink()
+
+	spanLink.SetTraceID(traceID)
+	spanLink.SetSpanID(spanID)
+	spanLink.TraceState().FromRaw("key1=val1,key2=val2")
+	spanLink.SetDroppedAttributesCount(10)
+
+	spanLink.Attributes().PutStr("str", "val")
+	spanLink.Attributes().PutInt("int", 10)
+
+	s := spanLink.Attributes().PutEmptySlice("slice")
+	s.AppendEmpty().SetEmptyMap().PutStr("map", "pass")
+
+	span := ptrace.NewSpan()
+	span.SetName("test")
+
+	il := pcommon.NewInstrumentationScope()
+	il.SetName("library")
+	il.SetVersion("version")
+
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.name", "svc")
+
+	return spanLink, span, il, resource
+}
+
+func Test_ParseEnum(t *testing.T) {
+	tests := []struct {
+		name string
+		want ottl.Enum
+	}{
+		{
+			name: "SPAN_KIND_UNSPECIFIED",
+			want: ottl.Enum(ptrace.SpanKindUnspecified),
+		},
+		{
+			name: "SPAN_KIND_INTERNAL",
+			want: ottl.Enum(ptrace.SpanKindInternal),
+		},
+		{
+			name: "SPAN_KIND_SERVER",
+			want: ottl.Enum(ptrace.SpanKindServer),
+		},
+		{
+			name: "SPAN_KIND_CLIENT",
+			want: ottl.Enum(ptrace.SpanKindClient),
+		},
+		{
+			name: "SPAN_KIND_PRODUCER",
+			want: ottl.Enum(ptrace.SpanKindProducer),
+		},
+		{
+			name: "SPAN_KIND_CONSUMER",
+			want: ottl.Enum(ptrace.SpanKindConsumer),
+		},
+		{
+			name: "STATUS_CODE_UNSET",
+			want: ottl.Enum(ptrace.StatusCodeUnset),
+		},
+		{
+			name: "STATUS_CODE_OK",
+			want: ottl.Enum(ptrace.StatusCodeOk),
+		},
+		{
+			name: "STATUS_CODE_ERROR",
+			want: ottl.Enum(ptrace.StatusCodeError),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := parseEnum((*ottl.EnumSymbol)(ottltest.Strp(tt.name)))
+			assert.NoError(t, err)
+			assert.Equal(t, *actual, tt.want)
+		})
+	}
+}
+
+func Test_ParseEnum_False(t *testing.T) {
+	tests := []struct {
+		name       string
+		enumSymbol *ottl.EnumSymbol
+	}{
+		{
+			name:       "unknown enum symbol",
+			enumSymbol: (*ottl.EnumSymbol)(ottltest.Strp("not an enum")),
+		},
+		{
+			name:       "nil enum symbol",
+			enumSymbol: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := parseEnum(tt.enumSymbol)
+			assert.Error(t, err)
+			assert.Nil(t, actual)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strconv"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -133,6 +134,37 @@ func (l *listGetter[K]) Get(ctx context.Context, tCtx K) (interface{}, error) {
 	return evaluated, nil
 }
 
+type mapGetter[K any] struct {
+	keys   []string
+	values []Getter[K]
+}
+
+func (m *mapGetter[K]) Get(ctx context.Context, tCtx K) (interface{}, error) {
+	result := pcommon.NewMap()
+	result.EnsureCapacity(len(m.keys))
+
+	for i, k := range m.keys {
+		val, err := m.values[i].Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		switch v := val.(type) {
+		case pcommon.Map:
+			v.CopyTo(result.PutEmptyMap(k))
+		case pcommon.Slice:
+			v.CopyTo(result.PutEmptySlice(k))
+		case pcommon.Value:
+			v.CopyTo(result.PutEmpty(k))
+		default:
+			if err = result.PutEmpty(k).FromRaw(v); err != nil {
+				return nil, fmt.Errorf("unsupported value for map key %q: %w", k, err)
+			}
+		}
+	}
+
+	return result, nil
+}
+
 // StringGetter is a Getter that must return a string.
 type StringGetter[K any] interface {
 	// Get retrieves a string value.  If the value is not a string, an error is returned.
@@ -151,6 +183,18 @@ type PMapGetter[K any] interface {
 	Get(ctx context.Context, tCtx K) (pcommon.Map, error)
 }
 
+// TimeGetter is a Getter that must return a time.Time.
+type TimeGetter[K any] interface {
+	// Get retrieves a time.Time value.  If the value is not a time.Time, an error is returned.
+	Get(ctx context.Context, tCtx K) (time.Time, error)
+}
+
+// DurationGetter is a Getter that must return a time.Duration.
+type DurationGetter[K any] interface {
+	// Get retrieves a time.Duration value.  If the value is not a time.Duration, an error is returned.
+	Get(ctx context.Context, tCtx K) (time.Duration, error)
+}
+
 type StandardTypeGetter[K any, T any] struct {
 	Getter func(ctx context.Context, tCtx K) (interface{}, error)
 }
@@ -333,6 +377,22 @@ func (p *Parser[K]) newGetter(val value) (Getter[K], error) {
 		return &lg, nil
 	}
 
+	if val.Map != nil {
+		mg := mapGetter[K]{
+			keys:   make([]string, len(val.Map.Values)),
+			values: make([]Getter[K], len(val.Map.Values)),
+		}
+		for i, item := range val.Map.Values {
+			getter, err := p.newGetter(*item.Value)
+			if err != nil {
+				return nil, err
+			}
+			mg.keys[i] = *item.Key
+			mg.values[i] = getter
+		}
+		return &mg, nil
+	}
+
 	if val.MathExpression == nil {
 		// In practice, can't happen since the DSL grammar guarantees one is set
 		return nil, fmt.Errorf("no value field set. This is a bug in the OpenTelemetry Transformation Language")
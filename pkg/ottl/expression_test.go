@@ -412,6 +412,71 @@ func Test_newGetter(t *testing.T) {
 			},
 			want: []any{"test0", int64(1)},
 		},
+		{
+			name: "empty map",
+			val: value{
+				Map: &mapValue{},
+			},
+			want: pcommon.NewMap(),
+		},
+		{
+			name: "map",
+			val: value{
+				Map: &mapValue{
+					Values: []mapItem{
+						{
+							Key: ottltest.Strp("string"),
+							Value: &value{
+								String: ottltest.Strp("test"),
+							},
+						},
+						{
+							Key: ottltest.Strp("int"),
+							Value: &value{
+								Literal: &mathExprLiteral{
+									Int: ottltest.Intp(1),
+								},
+							},
+						},
+						{
+							Key: ottltest.Strp("list"),
+							Value: &value{
+								List: &list{
+									Values: []value{
+										{
+											Bool: (*boolean)(ottltest.Boolp(true)),
+										},
+									},
+								},
+							},
+						},
+						{
+							Key: ottltest.Strp("map"),
+							Value: &value{
+								Map: &mapValue{
+									Values: []mapItem{
+										{
+											Key: ottltest.Strp("nested"),
+											Value: &value{
+												IsNil: (*isNil)(ottltest.Boolp(true)),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("string", "test")
+				m.PutInt("int", 1)
+				m.PutEmptySlice("list").AppendEmpty().SetBool(true)
+				m.PutEmptyMap("map").PutEmpty("nested")
+				return m
+			}(),
+		},
 	}
 
 	functions := CreateFactoryMap(
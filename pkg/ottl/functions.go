@@ -9,7 +9,9 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/iancoleman/strcase"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
@@ -19,6 +21,58 @@ type EnumParser func(*EnumSymbol) (*Enum, error)
 
 type Enum int64
 
+// optionalManager is implemented by Optional so that its value can be set
+// through reflection when parsing the arguments of a function.
+type optionalManager interface {
+	set(val any) reflect.Value
+}
+
+var optionalManagerType = reflect.TypeOf((*optionalManager)(nil)).Elem()
+
+// Optional is used to represent an optional function argument.
+// Optional arguments must be the last fields of an Arguments struct and
+// are left empty when the argument is not passed to the function.
+type Optional[T any] struct {
+	val      T
+	hasValue bool
+}
+
+// This is called only by reflection.
+// nolint:unused
+func (o Optional[T]) set(val any) reflect.Value {
+	return reflect.ValueOf(Optional[T]{
+		val:      val.(T),
+		hasValue: true,
+	})
+}
+
+// IsEmpty returns true if the argument was not passed to the function.
+func (o Optional[T]) IsEmpty() bool {
+	return !o.hasValue
+}
+
+// Get returns the value of the argument, or the zero value of T if it is empty.
+func (o Optional[T]) Get() T {
+	return o.val
+}
+
+// GetOr returns the value of the argument, or value if it is empty.
+func (o Optional[T]) GetOr(value T) T {
+	if !o.hasValue {
+		return value
+	}
+	return o.val
+}
+
+// NewTestingOptional allows creating an Optional with a value already populated for use in testing
+// OTTL functions.
+func NewTestingOptional[T any](val T) Optional[T] {
+	return Optional[T]{
+		val:      val,
+		hasValue: true,
+	}
+}
+
 func (p *Parser[K]) newFunctionCall(ed editor) (Expr[K], error) {
 	f, ok := p.functions[ed.Function]
 	if !ok {
@@ -35,7 +89,13 @@ func (p *Parser[K]) newFunctionCall(ed editor) (Expr[K], error) {
 			return Expr[K]{}, fmt.Errorf("factory for %s must return a pointer to an Arguments value in its CreateDefaultArguments method", ed.Function)
 		}
 
-		err := p.buildArgs(ed, reflect.ValueOf(args).Elem())
+		// The default arguments are shared by every call to the function, so
+		// they are copied to keep optional arguments of other calls from leaking.
+		argsVal := reflect.New(reflect.TypeOf(args).Elem())
+		argsVal.Elem().Set(reflect.ValueOf(args).Elem())
+		args = argsVal.Interface()
+
+		err := p.buildArgs(ed, argsVal.Elem())
 		if err != nil {
 			return Expr[K]{}, fmt.Errorf("error while parsing arguments for call to '%v': %w", ed.Function, err)
 		}
@@ -50,15 +110,13 @@ func (p *Parser[K]) newFunctionCall(ed editor) (Expr[K], error) {
 }
 
 func (p *Parser[K]) buildArgs(ed editor, argsVal reflect.Value) error {
-	if len(ed.Arguments) != argsVal.NumField() {
-		return fmt.Errorf("incorrect number of arguments. Expected: %d Received: %d", argsVal.NumField(), len(ed.Arguments))
-	}
-
 	argsType := argsVal.Type()
+	params := make([]*argumentParameter, argsVal.NumField())
+	paramsByName := make(map[string]*argumentParameter, argsVal.NumField())
+	requiredParams := 0
 
 	for i := 0; i < argsVal.NumField(); i++ {
 		field := argsVal.Field(i)
-		fieldType := field.Type()
 
 		fieldTag, ok := argsType.Field(i).Tag.Lookup("ottlarg")
 
@@ -72,25 +130,105 @@ func (p *Parser[K]) buildArgs(ed editor, argsVal reflect.Value) error {
 			return fmt.Errorf("ottlarg struct tag on field '%s' is not a valid integer: %w", argsType.Field(i).Name, err)
 		}
 
-		if argNum < 0 || argNum >= len(ed.Arguments) {
-			return fmt.Errorf("ottlarg struct tag on field '%s' has value %d, but must be between 0 and %d", argsType.Field(i).Name, argNum, len(ed.Arguments))
+		if argNum < 0 || argNum >= argsVal.NumField() {
+			return fmt.Errorf("ottlarg struct tag on field '%s' has value %d, but must be between 0 and %d", argsType.Field(i).Name, argNum, argsVal.NumField())
 		}
 
-		argVal := ed.Arguments[argNum]
+		if params[argNum] != nil {
+			return fmt.Errorf("ottlarg struct tag on field '%s' has value %d, which is already used by field '%s'", argsType.Field(i).Name, argNum, params[argNum].fieldName)
+		}
+
+		param := &argumentParameter{
+			field:     field,
+			fieldName: argsType.Field(i).Name,
+			name:      strcase.ToSnake(argsType.Field(i).Name),
+			optional:  field.Type().Implements(optionalManagerType),
+		}
+		params[argNum] = param
+		paramsByName[param.name] = param
+		if !param.optional {
+			requiredParams++
+		}
+	}
+
+	for i, param := range params {
+		if param.optional != (i >= requiredParams) {
+			return fmt.Errorf("optional arguments must be after all required arguments, but field '%s' is not", param.fieldName)
+		}
+	}
 
-		var val any
-		if fieldType.Kind() == reflect.Slice {
-			val, err = p.buildSliceArg(argVal, fieldType)
+	namedArgumentFound := false
+	for i, arg := range ed.Arguments {
+		var param *argumentParameter
+		if arg.Name != "" {
+			namedArgumentFound = true
+			var ok bool
+			if param, ok = paramsByName[arg.Name]; !ok {
+				return fmt.Errorf("no parameter named '%s'", arg.Name)
+			}
+			if param.set {
+				return fmt.Errorf("parameter '%s' is set more than once", arg.Name)
+			}
 		} else {
-			val, err = p.buildArg(argVal, fieldType)
+			if namedArgumentFound {
+				return fmt.Errorf("unnamed argument used after named argument at position %v", i)
+			}
+			if i >= len(params) {
+				return fmt.Errorf("incorrect number of arguments. Expected: %d Received: %d", len(params), len(ed.Arguments))
+			}
+			param = params[i]
 		}
 
-		if err != nil {
+		if err := p.buildParam(param, arg.Value); err != nil {
 			return fmt.Errorf("invalid argument at position %v: %w", i, err)
 		}
-		field.Set(reflect.ValueOf(val))
 	}
 
+	for _, param := range params {
+		if !param.set && !param.optional {
+			if namedArgumentFound {
+				return fmt.Errorf("missing required argument '%s'", param.name)
+			}
+			return fmt.Errorf("incorrect number of arguments. Expected: %d Received: %d", requiredParams, len(ed.Arguments))
+		}
+	}
+
+	return nil
+}
+
+// argumentParameter is a field of an Arguments struct that receives the
+// argument passed either at its position or by its name.
+type argumentParameter struct {
+	field     reflect.Value
+	fieldName string
+	name      string
+	optional  bool
+	set       bool
+}
+
+func (p *Parser[K]) buildParam(param *argumentParameter, argVal value) error {
+	fieldType := param.field.Type()
+	if param.optional {
+		fieldType = fieldType.Field(0).Type
+	}
+
+	var val any
+	var err error
+	if fieldType.Kind() == reflect.Slice {
+		val, err = p.buildSliceArg(argVal, fieldType)
+	} else {
+		val, err = p.buildArg(argVal, fieldType)
+	}
+	if err != nil {
+		return err
+	}
+
+	if param.optional {
+		param.field.Set(param.field.Interface().(optionalManager).set(val))
+	} else {
+		param.field.Set(reflect.ValueOf(val))
+	}
+	param.set = true
 	return nil
 }
 
@@ -218,6 +356,18 @@ func (p *Parser[K]) buildArg(argVal value, argType reflect.Type) (any, error) {
 			return nil, err
 		}
 		return StandardTypeGetter[K, pcommon.Map]{Getter: arg.Get}, nil
+	case strings.HasPrefix(name, "TimeGetter"):
+		arg, err := p.newGetter(argVal)
+		if err != nil {
+			return nil, err
+		}
+		return StandardTypeGetter[K, time.Time]{Getter: arg.Get}, nil
+	case strings.HasPrefix(name, "DurationGetter"):
+		arg, err := p.newGetter(argVal)
+		if err != nil {
+			return nil, err
+		}
+		return StandardTypeGetter[K, time.Duration]{Getter: arg.Get}, nil
 	case name == "Enum":
 		arg, err := p.enumParser(argVal.Enum)
 		if err != nil {
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
@@ -105,16 +106,18 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "unknown function",
 			inv: editor{
 				Function:  "unknownfunc",
-				Arguments: []value{},
+				Arguments: []argument{},
 			},
 		},
 		{
 			name: "not accessor",
 			inv: editor{
 				Function: "testing_getsetter",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						String: ottltest.Strp("not path"),
+						Value: value{
+							String: ottltest.Strp("not path"),
+						},
 					},
 				},
 			},
@@ -123,11 +126,13 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "not reader (invalid function)",
 			inv: editor{
 				Function: "testing_getter",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Literal: &mathExprLiteral{
-							Converter: &converter{
-								Function: "Unknownfunc",
+						Value: value{
+							Literal: &mathExprLiteral{
+								Converter: &converter{
+									Function: "Unknownfunc",
+								},
 							},
 						},
 					},
@@ -138,20 +143,24 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "not enough args",
 			inv: editor{
 				Function: "testing_multiple_args",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Literal: &mathExprLiteral{
-							Path: &Path{
-								Fields: []Field{
-									{
-										Name: "name",
+						Value: value{
+							Literal: &mathExprLiteral{
+								Path: &Path{
+									Fields: []Field{
+										{
+											Name: "name",
+										},
 									},
 								},
 							},
 						},
 					},
 					{
-						String: ottltest.Strp("test"),
+						Value: value{
+							String: ottltest.Strp("test"),
+						},
 					},
 				},
 			},
@@ -160,23 +169,29 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "too many args",
 			inv: editor{
 				Function: "testing_multiple_args",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Literal: &mathExprLiteral{
-							Path: &Path{
-								Fields: []Field{
-									{
-										Name: "name",
+						Value: value{
+							Literal: &mathExprLiteral{
+								Path: &Path{
+									Fields: []Field{
+										{
+											Name: "name",
+										},
 									},
 								},
 							},
 						},
 					},
 					{
-						String: ottltest.Strp("test"),
+						Value: value{
+							String: ottltest.Strp("test"),
+						},
 					},
 					{
-						String: ottltest.Strp("test"),
+						Value: value{
+							String: ottltest.Strp("test"),
+						},
 					},
 				},
 			},
@@ -185,15 +200,19 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "not enough args with telemetrySettings",
 			inv: editor{
 				Function: "testing_telemetry_settings_first",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						String: ottltest.Strp("test"),
+						Value: value{
+							String: ottltest.Strp("test"),
+						},
 					},
 					{
-						List: &list{
-							Values: []value{
-								{
-									String: ottltest.Strp("test"),
+						Value: value{
+							List: &list{
+								Values: []value{
+									{
+										String: ottltest.Strp("test"),
+									},
 								},
 							},
 						},
@@ -205,27 +224,35 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "too many args with telemetrySettings",
 			inv: editor{
 				Function: "testing_telemetry_settings_first",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						String: ottltest.Strp("test"),
+						Value: value{
+							String: ottltest.Strp("test"),
+						},
 					},
 					{
-						List: &list{
-							Values: []value{
-								{
-									String: ottltest.Strp("test"),
+						Value: value{
+							List: &list{
+								Values: []value{
+									{
+										String: ottltest.Strp("test"),
+									},
 								},
 							},
 						},
 					},
 					{
-						Literal: &mathExprLiteral{
-							Int: ottltest.Intp(10),
+						Value: value{
+							Literal: &mathExprLiteral{
+								Int: ottltest.Intp(10),
+							},
 						},
 					},
 					{
-						Literal: &mathExprLiteral{
-							Int: ottltest.Intp(10),
+						Value: value{
+							Literal: &mathExprLiteral{
+								Int: ottltest.Intp(10),
+							},
 						},
 					},
 				},
@@ -235,10 +262,12 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "not matching arg type",
 			inv: editor{
 				Function: "testing_string",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Literal: &mathExprLiteral{
-							Int: ottltest.Intp(10),
+						Value: value{
+							Literal: &mathExprLiteral{
+								Int: ottltest.Intp(10),
+							},
 						},
 					},
 				},
@@ -248,15 +277,21 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "not matching arg type when byte slice",
 			inv: editor{
 				Function: "testing_byte_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						String: ottltest.Strp("test"),
+						Value: value{
+							String: ottltest.Strp("test"),
+						},
 					},
 					{
-						String: ottltest.Strp("test"),
+						Value: value{
+							String: ottltest.Strp("test"),
+						},
 					},
 					{
-						String: ottltest.Strp("test"),
+						Value: value{
+							String: ottltest.Strp("test"),
+						},
 					},
 				},
 			},
@@ -265,16 +300,18 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "mismatching slice element type",
 			inv: editor{
 				Function: "testing_string_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						List: &list{
-							Values: []value{
-								{
-									String: ottltest.Strp("test"),
-								},
-								{
-									Literal: &mathExprLiteral{
-										Int: ottltest.Intp(10),
+						Value: value{
+							List: &list{
+								Values: []value{
+									{
+										String: ottltest.Strp("test"),
+									},
+									{
+										Literal: &mathExprLiteral{
+											Int: ottltest.Intp(10),
+										},
 									},
 								},
 							},
@@ -287,9 +324,11 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "mismatching slice argument type",
 			inv: editor{
 				Function: "testing_string_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						String: ottltest.Strp("test"),
+						Value: value{
+							String: ottltest.Strp("test"),
+						},
 					},
 				},
 			},
@@ -304,9 +343,11 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "Enum not found",
 			inv: editor{
 				Function: "testing_enum",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Enum: (*EnumSymbol)(ottltest.Strp("SYMBOL_NOT_FOUND")),
+						Value: value{
+							Enum: (*EnumSymbol)(ottltest.Strp("SYMBOL_NOT_FOUND")),
+						},
 					},
 				},
 			},
@@ -321,9 +362,11 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "no struct tags",
 			inv: editor{
 				Function: "no_struct_tag",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						String: ottltest.Strp("str"),
+						Value: value{
+							String: ottltest.Strp("str"),
+						},
 					},
 				},
 			},
@@ -332,9 +375,11 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "using the wrong struct tag",
 			inv: editor{
 				Function: "wrong_struct_tag",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						String: ottltest.Strp("str"),
+						Value: value{
+							String: ottltest.Strp("str"),
+						},
 					},
 				},
 			},
@@ -343,9 +388,11 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "non-integer struct tags",
 			inv: editor{
 				Function: "bad_struct_tag",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						String: ottltest.Strp("str"),
+						Value: value{
+							String: ottltest.Strp("str"),
+						},
 					},
 				},
 			},
@@ -354,9 +401,11 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "struct tag index too low",
 			inv: editor{
 				Function: "negative_struct_tag",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						String: ottltest.Strp("str"),
+						Value: value{
+							String: ottltest.Strp("str"),
+						},
 					},
 				},
 			},
@@ -365,9 +414,11 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 			name: "struct tag index too high",
 			inv: editor{
 				Function: "out_of_bounds_struct_tag",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						String: ottltest.Strp("str"),
+						Value: value{
+							String: ottltest.Strp("str"),
+						},
 					},
 				},
 			},
@@ -400,10 +451,12 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "no arguments",
 			inv: editor{
 				Function: "testing_noop",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						List: &list{
-							Values: []value{},
+						Value: value{
+							List: &list{
+								Values: []value{},
+							},
 						},
 					},
 				},
@@ -414,10 +467,12 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "empty slice arg",
 			inv: editor{
 				Function: "testing_string_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						List: &list{
-							Values: []value{},
+						Value: value{
+							List: &list{
+								Values: []value{},
+							},
 						},
 					},
 				},
@@ -428,18 +483,20 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "string slice arg",
 			inv: editor{
 				Function: "testing_string_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						List: &list{
-							Values: []value{
-								{
-									String: ottltest.Strp("test"),
-								},
-								{
-									String: ottltest.Strp("test"),
-								},
-								{
-									String: ottltest.Strp("test"),
+						Value: value{
+							List: &list{
+								Values: []value{
+									{
+										String: ottltest.Strp("test"),
+									},
+									{
+										String: ottltest.Strp("test"),
+									},
+									{
+										String: ottltest.Strp("test"),
+									},
 								},
 							},
 						},
@@ -452,23 +509,25 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "float slice arg",
 			inv: editor{
 				Function: "testing_float_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						List: &list{
-							Values: []value{
-								{
-									Literal: &mathExprLiteral{
-										Float: ottltest.Floatp(1.1),
+						Value: value{
+							List: &list{
+								Values: []value{
+									{
+										Literal: &mathExprLiteral{
+											Float: ottltest.Floatp(1.1),
+										},
 									},
-								},
-								{
-									Literal: &mathExprLiteral{
-										Float: ottltest.Floatp(1.2),
+									{
+										Literal: &mathExprLiteral{
+											Float: ottltest.Floatp(1.2),
+										},
 									},
-								},
-								{
-									Literal: &mathExprLiteral{
-										Float: ottltest.Floatp(1.3),
+									{
+										Literal: &mathExprLiteral{
+											Float: ottltest.Floatp(1.3),
+										},
 									},
 								},
 							},
@@ -482,23 +541,25 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "int slice arg",
 			inv: editor{
 				Function: "testing_int_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						List: &list{
-							Values: []value{
-								{
-									Literal: &mathExprLiteral{
-										Int: ottltest.Intp(1),
+						Value: value{
+							List: &list{
+								Values: []value{
+									{
+										Literal: &mathExprLiteral{
+											Int: ottltest.Intp(1),
+										},
 									},
-								},
-								{
-									Literal: &mathExprLiteral{
-										Int: ottltest.Intp(1),
+									{
+										Literal: &mathExprLiteral{
+											Int: ottltest.Intp(1),
+										},
 									},
-								},
-								{
-									Literal: &mathExprLiteral{
-										Int: ottltest.Intp(1),
+									{
+										Literal: &mathExprLiteral{
+											Int: ottltest.Intp(1),
+										},
 									},
 								},
 							},
@@ -512,72 +573,74 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "getter slice arg",
 			inv: editor{
 				Function: "testing_getter_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						List: &list{
-							Values: []value{
-								{
-									Literal: &mathExprLiteral{
-										Path: &Path{
-											Fields: []Field{
-												{
-													Name: "name",
+						Value: value{
+							List: &list{
+								Values: []value{
+									{
+										Literal: &mathExprLiteral{
+											Path: &Path{
+												Fields: []Field{
+													{
+														Name: "name",
+													},
 												},
 											},
 										},
 									},
-								},
-								{
-									String: ottltest.Strp("test"),
-								},
-								{
-									Literal: &mathExprLiteral{
-										Int: ottltest.Intp(1),
+									{
+										String: ottltest.Strp("test"),
 									},
-								},
-								{
-									Literal: &mathExprLiteral{
-										Float: ottltest.Floatp(1.1),
+									{
+										Literal: &mathExprLiteral{
+											Int: ottltest.Intp(1),
+										},
 									},
-								},
-								{
-									Bool: (*boolean)(ottltest.Boolp(true)),
-								},
-								{
-									Enum: (*EnumSymbol)(ottltest.Strp("TEST_ENUM")),
-								},
-								{
-									List: &list{
-										Values: []value{
-											{
-												String: ottltest.Strp("test"),
-											},
-											{
-												String: ottltest.Strp("test"),
-											},
+									{
+										Literal: &mathExprLiteral{
+											Float: ottltest.Floatp(1.1),
 										},
 									},
-								},
-								{
-									List: &list{
-										Values: []value{
-											{
-												String: ottltest.Strp("test"),
+									{
+										Bool: (*boolean)(ottltest.Boolp(true)),
+									},
+									{
+										Enum: (*EnumSymbol)(ottltest.Strp("TEST_ENUM")),
+									},
+									{
+										List: &list{
+											Values: []value{
+												{
+													String: ottltest.Strp("test"),
+												},
+												{
+													String: ottltest.Strp("test"),
+												},
 											},
-											{
-												List: &list{
-													Values: []value{
-														{
-															String: ottltest.Strp("test"),
-														},
-														{
-															List: &list{
-																Values: []value{
-																	{
-																		String: ottltest.Strp("test"),
-																	},
-																	{
-																		String: ottltest.Strp("test"),
+										},
+									},
+									{
+										List: &list{
+											Values: []value{
+												{
+													String: ottltest.Strp("test"),
+												},
+												{
+													List: &list{
+														Values: []value{
+															{
+																String: ottltest.Strp("test"),
+															},
+															{
+																List: &list{
+																	Values: []value{
+																		{
+																			String: ottltest.Strp("test"),
+																		},
+																		{
+																			String: ottltest.Strp("test"),
+																		},
 																	},
 																},
 															},
@@ -587,18 +650,20 @@ func Test_NewFunctionCall(t *testing.T) {
 											},
 										},
 									},
-								},
-								{
-									Literal: &mathExprLiteral{
-										Converter: &converter{
-											Function: "testing_getter",
-											Arguments: []value{
-												{
-													Literal: &mathExprLiteral{
-														Path: &Path{
-															Fields: []Field{
-																{
-																	Name: "name",
+									{
+										Literal: &mathExprLiteral{
+											Converter: &converter{
+												Function: "testing_getter",
+												Arguments: []argument{
+													{
+														Value: value{
+															Literal: &mathExprLiteral{
+																Path: &Path{
+																	Fields: []Field{
+																		{
+																			Name: "name",
+																		},
+																	},
 																},
 															},
 														},
@@ -619,15 +684,17 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "stringgetter slice arg",
 			inv: editor{
 				Function: "testing_stringgetter_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						List: &list{
-							Values: []value{
-								{
-									String: ottltest.Strp("test"),
-								},
-								{
-									String: ottltest.Strp("also test"),
+						Value: value{
+							List: &list{
+								Values: []value{
+									{
+										String: ottltest.Strp("test"),
+									},
+									{
+										String: ottltest.Strp("also test"),
+									},
 								},
 							},
 						},
@@ -640,16 +707,18 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "floatgetter slice arg",
 			inv: editor{
 				Function: "testing_floatgetter_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						List: &list{
-							Values: []value{
-								{
-									String: ottltest.Strp("1.1"),
-								},
-								{
-									Literal: &mathExprLiteral{
-										Float: ottltest.Floatp(1),
+						Value: value{
+							List: &list{
+								Values: []value{
+									{
+										String: ottltest.Strp("1.1"),
+									},
+									{
+										Literal: &mathExprLiteral{
+											Float: ottltest.Floatp(1),
+										},
 									},
 								},
 							},
@@ -663,27 +732,29 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "pmapgetter slice arg",
 			inv: editor{
 				Function: "testing_pmapgetter_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						List: &list{
-							Values: []value{
-								{
-									Literal: &mathExprLiteral{
-										Path: &Path{
-											Fields: []Field{
-												{
-													Name: "name",
+						Value: value{
+							List: &list{
+								Values: []value{
+									{
+										Literal: &mathExprLiteral{
+											Path: &Path{
+												Fields: []Field{
+													{
+														Name: "name",
+													},
 												},
 											},
 										},
 									},
-								},
-								{
-									Literal: &mathExprLiteral{
-										Path: &Path{
-											Fields: []Field{
-												{
-													Name: "name",
+									{
+										Literal: &mathExprLiteral{
+											Path: &Path{
+												Fields: []Field{
+													{
+														Name: "name",
+													},
 												},
 											},
 										},
@@ -700,16 +771,18 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "stringlikegetter slice arg",
 			inv: editor{
 				Function: "testing_stringlikegetter_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						List: &list{
-							Values: []value{
-								{
-									String: ottltest.Strp("test"),
-								},
-								{
-									Literal: &mathExprLiteral{
-										Int: ottltest.Intp(1),
+						Value: value{
+							List: &list{
+								Values: []value{
+									{
+										String: ottltest.Strp("test"),
+									},
+									{
+										Literal: &mathExprLiteral{
+											Int: ottltest.Intp(1),
+										},
 									},
 								},
 							},
@@ -723,16 +796,18 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "floatlikegetter slice arg",
 			inv: editor{
 				Function: "testing_floatlikegetter_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						List: &list{
-							Values: []value{
-								{
-									String: ottltest.Strp("1.1"),
-								},
-								{
-									Literal: &mathExprLiteral{
-										Float: ottltest.Floatp(1.1),
+						Value: value{
+							List: &list{
+								Values: []value{
+									{
+										String: ottltest.Strp("1.1"),
+									},
+									{
+										Literal: &mathExprLiteral{
+											Float: ottltest.Floatp(1.1),
+										},
 									},
 								},
 							},
@@ -746,13 +821,15 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "setter arg",
 			inv: editor{
 				Function: "testing_setter",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Literal: &mathExprLiteral{
-							Path: &Path{
-								Fields: []Field{
-									{
-										Name: "name",
+						Value: value{
+							Literal: &mathExprLiteral{
+								Path: &Path{
+									Fields: []Field{
+										{
+											Name: "name",
+										},
 									},
 								},
 							},
@@ -766,13 +843,15 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "getsetter arg",
 			inv: editor{
 				Function: "testing_getsetter",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Literal: &mathExprLiteral{
-							Path: &Path{
-								Fields: []Field{
-									{
-										Name: "name",
+						Value: value{
+							Literal: &mathExprLiteral{
+								Path: &Path{
+									Fields: []Field{
+										{
+											Name: "name",
+										},
 									},
 								},
 							},
@@ -786,13 +865,15 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "getter arg",
 			inv: editor{
 				Function: "testing_getter",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Literal: &mathExprLiteral{
-							Path: &Path{
-								Fields: []Field{
-									{
-										Name: "name",
+						Value: value{
+							Literal: &mathExprLiteral{
+								Path: &Path{
+									Fields: []Field{
+										{
+											Name: "name",
+										},
 									},
 								},
 							},
@@ -806,9 +887,11 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "getter arg with nil literal",
 			inv: editor{
 				Function: "testing_getter",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						IsNil: (*isNil)(ottltest.Boolp(true)),
+						Value: value{
+							IsNil: (*isNil)(ottltest.Boolp(true)),
+						},
 					},
 				},
 			},
@@ -818,51 +901,55 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "getter arg with list",
 			inv: editor{
 				Function: "testing_getter",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						List: &list{
-							Values: []value{
-								{
-									String: ottltest.Strp("test"),
-								},
-								{
-									Literal: &mathExprLiteral{
-										Int: ottltest.Intp(1),
+						Value: value{
+							List: &list{
+								Values: []value{
+									{
+										String: ottltest.Strp("test"),
 									},
-								},
-								{
-									Literal: &mathExprLiteral{
-										Float: ottltest.Floatp(1.1),
+									{
+										Literal: &mathExprLiteral{
+											Int: ottltest.Intp(1),
+										},
 									},
-								},
-								{
-									Bool: (*boolean)(ottltest.Boolp(true)),
-								},
-								{
-									Bytes: (*byteSlice)(&[]byte{1, 2, 3, 4, 5, 6, 7, 8}),
-								},
-								{
-									Literal: &mathExprLiteral{
-										Path: &Path{
-											Fields: []Field{
-												{
-													Name: "name",
+									{
+										Literal: &mathExprLiteral{
+											Float: ottltest.Floatp(1.1),
+										},
+									},
+									{
+										Bool: (*boolean)(ottltest.Boolp(true)),
+									},
+									{
+										Bytes: (*byteSlice)(&[]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+									},
+									{
+										Literal: &mathExprLiteral{
+											Path: &Path{
+												Fields: []Field{
+													{
+														Name: "name",
+													},
 												},
 											},
 										},
 									},
-								},
-								{
-									Literal: &mathExprLiteral{
-										Converter: &converter{
-											Function: "testing_getter",
-											Arguments: []value{
-												{
-													Literal: &mathExprLiteral{
-														Path: &Path{
-															Fields: []Field{
-																{
-																	Name: "name",
+									{
+										Literal: &mathExprLiteral{
+											Converter: &converter{
+												Function: "testing_getter",
+												Arguments: []argument{
+													{
+														Value: value{
+															Literal: &mathExprLiteral{
+																Path: &Path{
+																	Fields: []Field{
+																		{
+																			Name: "name",
+																		},
+																	},
 																},
 															},
 														},
@@ -883,9 +970,11 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "stringgetter arg",
 			inv: editor{
 				Function: "testing_stringgetter",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						String: ottltest.Strp("test"),
+						Value: value{
+							String: ottltest.Strp("test"),
+						},
 					},
 				},
 			},
@@ -895,9 +984,11 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "stringlikegetter arg",
 			inv: editor{
 				Function: "testing_stringlikegetter",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Bool: (*boolean)(ottltest.Boolp(false)),
+						Value: value{
+							Bool: (*boolean)(ottltest.Boolp(false)),
+						},
 					},
 				},
 			},
@@ -907,9 +998,11 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "floatgetter arg",
 			inv: editor{
 				Function: "testing_floatgetter",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						String: ottltest.Strp("1.1"),
+						Value: value{
+							String: ottltest.Strp("1.1"),
+						},
 					},
 				},
 			},
@@ -919,9 +1012,11 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "floatlikegetter arg",
 			inv: editor{
 				Function: "testing_floatlikegetter",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Bool: (*boolean)(ottltest.Boolp(false)),
+						Value: value{
+							Bool: (*boolean)(ottltest.Boolp(false)),
+						},
 					},
 				},
 			},
@@ -931,10 +1026,40 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "intgetter arg",
 			inv: editor{
 				Function: "testing_intgetter",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Literal: &mathExprLiteral{
-							Int: ottltest.Intp(1),
+						Value: value{
+							Literal: &mathExprLiteral{
+								Int: ottltest.Intp(1),
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "timegetter arg",
+			inv: editor{
+				Function: "testing_timegetter",
+				Arguments: []argument{
+					{
+						Value: value{
+							String: ottltest.Strp("test"),
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "durationgetter arg",
+			inv: editor{
+				Function: "testing_durationgetter",
+				Arguments: []argument{
+					{
+						Value: value{
+							String: ottltest.Strp("test"),
 						},
 					},
 				},
@@ -945,13 +1070,15 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "pmapgetter arg",
 			inv: editor{
 				Function: "testing_pmapgetter",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Literal: &mathExprLiteral{
-							Path: &Path{
-								Fields: []Field{
-									{
-										Name: "name",
+						Value: value{
+							Literal: &mathExprLiteral{
+								Path: &Path{
+									Fields: []Field{
+										{
+											Name: "name",
+										},
 									},
 								},
 							},
@@ -965,9 +1092,11 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "string arg",
 			inv: editor{
 				Function: "testing_string",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						String: ottltest.Strp("test"),
+						Value: value{
+							String: ottltest.Strp("test"),
+						},
 					},
 				},
 			},
@@ -977,10 +1106,12 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "float arg",
 			inv: editor{
 				Function: "testing_float",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Literal: &mathExprLiteral{
-							Float: ottltest.Floatp(1.1),
+						Value: value{
+							Literal: &mathExprLiteral{
+								Float: ottltest.Floatp(1.1),
+							},
 						},
 					},
 				},
@@ -991,10 +1122,12 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "int arg",
 			inv: editor{
 				Function: "testing_int",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Literal: &mathExprLiteral{
-							Int: ottltest.Intp(1),
+						Value: value{
+							Literal: &mathExprLiteral{
+								Int: ottltest.Intp(1),
+							},
 						},
 					},
 				},
@@ -1005,9 +1138,11 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "bool arg",
 			inv: editor{
 				Function: "testing_bool",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Bool: (*boolean)(ottltest.Boolp(true)),
+						Value: value{
+							Bool: (*boolean)(ottltest.Boolp(true)),
+						},
 					},
 				},
 			},
@@ -1017,9 +1152,11 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "byteSlice arg",
 			inv: editor{
 				Function: "testing_byte_slice",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Bytes: (*byteSlice)(&[]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+						Value: value{
+							Bytes: (*byteSlice)(&[]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+						},
 					},
 				},
 			},
@@ -1029,29 +1166,37 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "multiple args",
 			inv: editor{
 				Function: "testing_multiple_args",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Literal: &mathExprLiteral{
-							Path: &Path{
-								Fields: []Field{
-									{
-										Name: "name",
+						Value: value{
+							Literal: &mathExprLiteral{
+								Path: &Path{
+									Fields: []Field{
+										{
+											Name: "name",
+										},
 									},
 								},
 							},
 						},
 					},
 					{
-						String: ottltest.Strp("test"),
+						Value: value{
+							String: ottltest.Strp("test"),
+						},
 					},
 					{
-						Literal: &mathExprLiteral{
-							Float: ottltest.Floatp(1.1),
+						Value: value{
+							Literal: &mathExprLiteral{
+								Float: ottltest.Floatp(1.1),
+							},
 						},
 					},
 					{
-						Literal: &mathExprLiteral{
-							Int: ottltest.Intp(1),
+						Value: value{
+							Literal: &mathExprLiteral{
+								Int: ottltest.Intp(1),
+							},
 						},
 					},
 				},
@@ -1062,9 +1207,11 @@ func Test_NewFunctionCall(t *testing.T) {
 			name: "Enum arg",
 			inv: editor{
 				Function: "testing_enum",
-				Arguments: []value{
+				Arguments: []argument{
 					{
-						Enum: (*EnumSymbol)(ottltest.Strp("TEST_ENUM")),
+						Value: value{
+							Enum: (*EnumSymbol)(ottltest.Strp("TEST_ENUM")),
+						},
 					},
 				},
 			},
@@ -1270,6 +1417,26 @@ func functionWithIntGetter(IntGetter[interface{}]) (ExprFunc[interface{}], error
 	}, nil
 }
 
+type timeGetterArguments struct {
+	TimeGetterArg TimeGetter[any] `ottlarg:"0"`
+}
+
+func functionWithTimeGetter(TimeGetter[interface{}]) (ExprFunc[interface{}], error) {
+	return func(context.Context, interface{}) (interface{}, error) {
+		return "anything", nil
+	}, nil
+}
+
+type durationGetterArguments struct {
+	DurationGetterArg DurationGetter[any] `ottlarg:"0"`
+}
+
+func functionWithDurationGetter(DurationGetter[interface{}]) (ExprFunc[interface{}], error) {
+	return func(context.Context, interface{}) (interface{}, error) {
+		return "anything", nil
+	}, nil
+}
+
 type pMapGetterArguments struct {
 	PMapArg PMapGetter[any] `ottlarg:"0"`
 }
@@ -1352,6 +1519,36 @@ func functionWithEnum(Enum) (ExprFunc[interface{}], error) {
 	}, nil
 }
 
+type optionalArgsArguments struct {
+	StringArg         string                      `ottlarg:"0"`
+	OptionalIntArg    Optional[int64]             `ottlarg:"1"`
+	OptionalGetterArg Optional[StringGetter[any]] `ottlarg:"2"`
+}
+
+func functionWithOptionalArgs(str string, optionalInt Optional[int64], optionalGetter Optional[StringGetter[any]]) (ExprFunc[any], error) {
+	return func(ctx context.Context, tCtx any) (interface{}, error) {
+		result := fmt.Sprintf("%s %d", str, optionalInt.GetOr(-1))
+		if !optionalGetter.IsEmpty() {
+			val, err := optionalGetter.Get().Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			result += " " + val
+		}
+		return result, nil
+	}, nil
+}
+
+type optionalBeforeRequiredArguments struct {
+	OptionalArg Optional[string] `ottlarg:"0"`
+	StringArg   string           `ottlarg:"1"`
+}
+
+type duplicateStructTagFunctionArguments struct {
+	StringArg      string `ottlarg:"0"`
+	OtherStringArg string `ottlarg:"0"`
+}
+
 type noStructTagFunctionArguments struct {
 	StringArg string
 }
@@ -1372,6 +1569,136 @@ type wrongTagFunctionArguments struct {
 	StringArg string `argument:"1"`
 }
 
+func Test_NewFunctionCall_OptionalAndNamedArguments(t *testing.T) {
+	functions := CreateFactoryMap(
+		createFactory[any](
+			"testing_optional_args",
+			&optionalArgsArguments{},
+			functionWithOptionalArgs,
+		),
+		createFactory[any](
+			"testing_optional_before_required",
+			&optionalBeforeRequiredArguments{},
+			functionThatHasAnError,
+		),
+		createFactory[any](
+			"testing_duplicate_struct_tag",
+			&duplicateStructTagFunctionArguments{},
+			functionThatHasAnError,
+		),
+	)
+	p, _ := NewParser(
+		functions,
+		testParsePath,
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+
+	tests := []struct {
+		name      string
+		statement string
+		want      any
+		wantErr   string
+	}{
+		{
+			name:      "optional arguments omitted",
+			statement: `testing_optional_args("str")`,
+			want:      "str -1",
+		},
+		{
+			name:      "optional arguments passed by position",
+			statement: `testing_optional_args("str", 1, "getter")`,
+			want:      "str 1 getter",
+		},
+		{
+			name:      "first optional argument passed by position",
+			statement: `testing_optional_args("str", 1)`,
+			want:      "str 1",
+		},
+		{
+			name:      "optional argument passed by name",
+			statement: `testing_optional_args("str", optional_getter_arg="getter")`,
+			want:      "str -1 getter",
+		},
+		{
+			name:      "all arguments passed by name",
+			statement: `testing_optional_args(optional_int_arg=2, string_arg="str")`,
+			want:      "str 2",
+		},
+		{
+			name:      "unnamed argument after named argument",
+			statement: `testing_optional_args(string_arg="str", 1)`,
+			wantErr:   "unnamed argument used after named argument",
+		},
+		{
+			name:      "unknown argument name",
+			statement: `testing_optional_args("str", unknown_arg=1)`,
+			wantErr:   "no parameter named 'unknown_arg'",
+		},
+		{
+			name:      "argument passed twice",
+			statement: `testing_optional_args("str", string_arg="str")`,
+			wantErr:   "parameter 'string_arg' is set more than once",
+		},
+		{
+			name:      "missing required named argument",
+			statement: `testing_optional_args(optional_int_arg=1)`,
+			wantErr:   "missing required argument 'string_arg'",
+		},
+		{
+			name:      "missing required argument",
+			statement: `testing_optional_args()`,
+			wantErr:   "incorrect number of arguments. Expected: 1 Received: 0",
+		},
+		{
+			name:      "too many arguments",
+			statement: `testing_optional_args("str", 1, "getter", "extra")`,
+			wantErr:   "incorrect number of arguments. Expected: 3 Received: 4",
+		},
+		{
+			name:      "invalid optional argument type",
+			statement: `testing_optional_args("str", optional_int_arg="1")`,
+			wantErr:   "must be an int",
+		},
+		{
+			name:      "optional argument before required argument",
+			statement: `testing_optional_before_required("str")`,
+			wantErr:   "optional arguments must be after all required arguments",
+		},
+		{
+			name:      "duplicate struct tag",
+			statement: `testing_duplicate_struct_tag("a", "b")`,
+			wantErr:   "which is already used by field 'StringArg'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement, err := p.ParseStatement(tt.statement)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			result, _, err := statement.Execute(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func Test_Optional(t *testing.T) {
+	var empty Optional[string]
+	assert.True(t, empty.IsEmpty())
+	assert.Equal(t, "", empty.Get())
+	assert.Equal(t, "default", empty.GetOr("default"))
+
+	set := NewTestingOptional("value")
+	assert.False(t, set.IsEmpty())
+	assert.Equal(t, "value", set.Get())
+	assert.Equal(t, "value", set.GetOr("default"))
+}
+
 func createFactory[A any](name string, args A, fn any) Factory[any] {
 	createFunction := func(fCtx FunctionContext, oArgs Arguments) (ExprFunc[any], error) {
 		fArgs, ok := oArgs.(A)
@@ -1504,6 +1831,16 @@ func defaultFunctionsForTests() map[string]Factory[any] {
 			&intGetterArguments{},
 			functionWithIntGetter,
 		),
+		createFactory[any](
+			"testing_timegetter",
+			&timeGetterArguments{},
+			functionWithTimeGetter,
+		),
+		createFactory[any](
+			"testing_durationgetter",
+			&durationGetterArguments{},
+			functionWithDurationGetter,
+		),
 		createFactory[any](
 			"testing_pmapgetter",
 			&pMapGetterArguments{},
@@ -189,8 +189,8 @@ func (c *comparison) checkForCustomError() error {
 
 // editor represents the function call of a statement.
 type editor struct {
-	Function  string  `parser:"@(Lowercase(Uppercase | Lowercase)*)"`
-	Arguments []value `parser:"'(' ( @@ ( ',' @@ )* )? ')'"`
+	Function  string     `parser:"@(Lowercase(Uppercase | Lowercase)*)"`
+	Arguments []argument `parser:"'(' ( @@ ( ',' @@ )* )? ')'"`
 	// If keys are matched return an error
 	Keys []Key `parser:"( @@ )*"`
 }
@@ -198,7 +198,7 @@ type editor struct {
 func (i *editor) checkForCustomError() error {
 	var err error
 	for _, arg := range i.Arguments {
-		err = arg.checkForCustomError()
+		err = arg.Value.checkForCustomError()
 		if err != nil {
 			return err
 		}
@@ -211,9 +211,16 @@ func (i *editor) checkForCustomError() error {
 
 // converter represents a converter function call.
 type converter struct {
-	Function  string  `parser:"@(Uppercase(Uppercase | Lowercase)*)"`
-	Arguments []value `parser:"'(' ( @@ ( ',' @@ )* )? ')'"`
-	Keys      []Key   `parser:"( @@ )*"`
+	Function  string     `parser:"@(Uppercase(Uppercase | Lowercase)*)"`
+	Arguments []argument `parser:"'(' ( @@ ( ',' @@ )* )? ')'"`
+	Keys      []Key      `parser:"( @@ )*"`
+}
+
+// argument represents an argument of a function call. Arguments are passed
+// by position unless they are prefixed with the name of the parameter.
+type argument struct {
+	Name  string `parser:"( @(Lowercase(Uppercase | Lowercase)*) Equal )?"`
+	Value value  `parser:"@@"`
 }
 
 // value represents a part of a parsed statement which is resolved to a value of some sort. This can be a telemetry path
@@ -226,6 +233,7 @@ type value struct {
 	String         *string          `parser:"| @String"`
 	Bool           *boolean         `parser:"| @Boolean"`
 	Enum           *EnumSymbol      `parser:"| @Uppercase"`
+	Map            *mapValue        `parser:"| @@"`
 	List           *list            `parser:"| @@)"`
 }
 
@@ -236,6 +244,12 @@ func (v *value) checkForCustomError() error {
 	if v.MathExpression != nil {
 		return v.MathExpression.checkForCustomError()
 	}
+	if v.Map != nil {
+		return v.Map.checkForCustomError()
+	}
+	if v.List != nil {
+		return v.List.checkForCustomError()
+	}
 	return nil
 }
 
@@ -259,6 +273,33 @@ type list struct {
 	Values []value `parser:"'[' (@@)* (',' @@)* ']'"`
 }
 
+func (l *list) checkForCustomError() error {
+	for _, v := range l.Values {
+		if err := v.checkForCustomError(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type mapValue struct {
+	Values []mapItem `parser:"'{' (@@ ( ',' @@ )* )? '}'"`
+}
+
+func (m *mapValue) checkForCustomError() error {
+	for _, item := range m.Values {
+		if err := item.Value.checkForCustomError(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type mapItem struct {
+	Key   *string `parser:"@String Colon"`
+	Value *value  `parser:"@@"`
+}
+
 // byteSlice type for capturing byte slices
 type byteSlice []byte
 
@@ -432,6 +473,10 @@ func buildLexer() *lexer.StatefulDefinition {
 		{Name: `Boolean`, Pattern: `\b(true|false)\b`},
 		{Name: `LParen`, Pattern: `\(`},
 		{Name: `RParen`, Pattern: `\)`},
+		{Name: `LBrace`, Pattern: `\{`},
+		{Name: `RBrace`, Pattern: `\}`},
+		{Name: `Colon`, Pattern: `\:`},
+		{Name: `Equal`, Pattern: `=`},
 		{Name: `Punct`, Pattern: `[,.\[\]]`},
 		{Name: `Uppercase`, Pattern: `[A-Z][A-Z0-9_]*`},
 		{Name: `Lowercase`, Pattern: `[a-z][a-z0-9_]*`},
@@ -78,7 +78,7 @@ func Test_lexer(t *testing.T) {
 			{"OpNot", "not"},
 			{"Boolean", "false"},
 		}},
-		{"nothing_recognizable", "{}", true, []result{
+		{"nothing_recognizable", "#$", true, []result{
 			{"", ""},
 		}},
 		{"basic_ident_expr", `set(attributes["bytes"], 0x0102030405060708)`, false, []result{
@@ -92,6 +92,30 @@ func Test_lexer(t *testing.T) {
 			{"Bytes", "0x0102030405060708"},
 			{"RParen", ")"},
 		}},
+		{"map literal", `{"foo": 1, "bar": [true]}`, false, []result{
+			{"LBrace", "{"},
+			{"String", `"foo"`},
+			{"Colon", ":"},
+			{"Int", "1"},
+			{"Punct", ","},
+			{"String", `"bar"`},
+			{"Colon", ":"},
+			{"Punct", "["},
+			{"Boolean", "true"},
+			{"Punct", "]"},
+			{"RBrace", "}"},
+		}},
+		{"named argument", `Time(value, location="UTC")`, false, []result{
+			{"Uppercase", "T"},
+			{"Lowercase", "ime"},
+			{"LParen", "("},
+			{"Lowercase", "value"},
+			{"Punct", ","},
+			{"Lowercase", "location"},
+			{"Equal", "="},
+			{"String", `"UTC"`},
+			{"RParen", ")"},
+		}},
 		{"Mixing case numbers and underscores", `aBCd_123E_4`, false, []result{
 			{"Lowercase", "a"},
 			{"Uppercase", "BC"},
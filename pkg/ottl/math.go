@@ -6,6 +6,7 @@ package ottl // import "github.com/open-telemetry/opentelemetry-collector-contri
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 func (p *Parser[K]) evaluateMathExpression(expr *mathExpression) (Getter[K], error) {
@@ -98,14 +99,62 @@ func attemptMathOperation[K any](lhs Getter[K], op mathOp, rhs Getter[K]) Getter
 					default:
 						return nil, fmt.Errorf("%v must be int64 or float64", y)
 					}
+				case time.Time:
+					return performOpTime(newX, y, op)
+				case time.Duration:
+					return performOpDuration(newX, y, op)
 				default:
-					return nil, fmt.Errorf("%v must be int64 or float64", x)
+					return nil, fmt.Errorf("%v must be int64, float64, time.Time or time.Duration", x)
 				}
 			},
 		},
 	}
 }
 
+// performOpTime supports subtracting two times, which results in a duration,
+// and adding or subtracting a duration to a time, which results in a time.
+func performOpTime(x time.Time, y any, op mathOp) (any, error) {
+	switch newY := y.(type) {
+	case time.Time:
+		if op == SUB {
+			return x.Sub(newY), nil
+		}
+		return nil, fmt.Errorf("only subtraction is supported between times, got %s", op.String())
+	case time.Duration:
+		switch op {
+		case ADD:
+			return x.Add(newY), nil
+		case SUB:
+			return x.Add(-newY), nil
+		}
+		return nil, fmt.Errorf("only addition and subtraction of a duration are supported for times, got %s", op.String())
+	default:
+		return nil, fmt.Errorf("%v must be time.Time or time.Duration", y)
+	}
+}
+
+// performOpDuration supports adding or subtracting two durations,
+// and adding a time to a duration, which results in a time.
+func performOpDuration(x time.Duration, y any, op mathOp) (any, error) {
+	switch newY := y.(type) {
+	case time.Duration:
+		switch op {
+		case ADD:
+			return x + newY, nil
+		case SUB:
+			return x - newY, nil
+		}
+		return nil, fmt.Errorf("only addition and subtraction are supported between durations, got %s", op.String())
+	case time.Time:
+		if op == ADD {
+			return newY.Add(x), nil
+		}
+		return nil, fmt.Errorf("only addition of a time is supported for durations, got %s", op.String())
+	default:
+		return nil, fmt.Errorf("%v must be time.Duration or time.Time", y)
+	}
+}
+
 func performOp[N int64 | float64](x N, y N, op mathOp) (N, error) {
 	switch op {
 	case ADD:
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -56,6 +57,24 @@ func threePointOne[K any]() (ExprFunc[K], error) {
 	}, nil
 }
 
+func timeOne[K any]() (ExprFunc[K], error) {
+	return func(context.Context, K) (interface{}, error) {
+		return time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC), nil
+	}, nil
+}
+
+func timeTwo[K any]() (ExprFunc[K], error) {
+	return func(context.Context, K) (interface{}, error) {
+		return time.Date(2023, 5, 1, 12, 30, 0, 0, time.UTC), nil
+	}, nil
+}
+
+func minute[K any]() (ExprFunc[K], error) {
+	return func(context.Context, K) (interface{}, error) {
+		return time.Minute, nil
+	}, nil
+}
+
 type sumArguments struct {
 	Ints []int64 `ottlarg:"0"`
 }
@@ -197,6 +216,31 @@ func Test_evaluateMathExpression(t *testing.T) {
 			input:    "4 / 2.0",
 			expected: 2.0,
 		},
+		{
+			name:     "time subtraction",
+			input:    "TimeTwo() - TimeOne()",
+			expected: 150 * time.Minute,
+		},
+		{
+			name:     "time and duration addition",
+			input:    "TimeOne() + Minute()",
+			expected: time.Date(2023, 5, 1, 10, 1, 0, 0, time.UTC),
+		},
+		{
+			name:     "time and duration subtraction",
+			input:    "TimeOne() - Minute()",
+			expected: time.Date(2023, 5, 1, 9, 59, 0, 0, time.UTC),
+		},
+		{
+			name:     "duration and time addition",
+			input:    "Minute() + TimeOne()",
+			expected: time.Date(2023, 5, 1, 10, 1, 0, 0, time.UTC),
+		},
+		{
+			name:     "duration arithmetic",
+			input:    "Minute() + Minute() - (TimeTwo() - TimeOne())",
+			expected: -148 * time.Minute,
+		},
 	}
 
 	functions := CreateFactoryMap(
@@ -204,6 +248,9 @@ func Test_evaluateMathExpression(t *testing.T) {
 		createFactory("Two", &struct{}{}, two[any]),
 		createFactory("ThreePointOne", &struct{}{}, threePointOne[any]),
 		createFactory("Sum", &sumArguments{}, sum[any]),
+		createFactory("TimeOne", &struct{}{}, timeOne[any]),
+		createFactory("TimeTwo", &struct{}{}, timeTwo[any]),
+		createFactory("Minute", &struct{}{}, minute[any]),
 	)
 
 	p, _ := NewParser[any](
@@ -240,6 +287,30 @@ func Test_evaluateMathExpression_error(t *testing.T) {
 			name:  "divide by 0 is gracefully handled",
 			input: "1 / 0",
 		},
+		{
+			name:  "time addition",
+			input: "TimeOne() + TimeTwo()",
+		},
+		{
+			name:  "time multiplication",
+			input: "TimeOne() * Minute()",
+		},
+		{
+			name:  "time and int subtraction",
+			input: "TimeOne() - 1",
+		},
+		{
+			name:  "duration and time subtraction",
+			input: "Minute() - TimeOne()",
+		},
+		{
+			name:  "duration and int addition",
+			input: "Minute() + 1",
+		},
+		{
+			name:  "int and duration addition",
+			input: "1 + Minute()",
+		},
 	}
 
 	functions := CreateFactoryMap(
@@ -247,6 +318,9 @@ func Test_evaluateMathExpression_error(t *testing.T) {
 		createFactory("two", &struct{}{}, two[any]),
 		createFactory("threePointOne", &struct{}{}, threePointOne[any]),
 		createFactory("sum", &sumArguments{}, sum[any]),
+		createFactory("TimeOne", &struct{}{}, timeOne[any]),
+		createFactory("TimeTwo", &struct{}{}, timeTwo[any]),
+		createFactory("Minute", &struct{}{}, minute[any]),
 	)
 
 	p, _ := NewParser[any](
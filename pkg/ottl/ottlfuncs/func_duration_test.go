@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Duration(t *testing.T) {
+	tests := []struct {
+		name     string
+		duration string
+		expected time.Duration
+	}{
+		{
+			name:     "hours minutes seconds",
+			duration: "1h2m3s",
+			expected: time.Hour + 2*time.Minute + 3*time.Second,
+		},
+		{
+			name:     "milliseconds",
+			duration: "150ms",
+			expected: 150 * time.Millisecond,
+		},
+		{
+			name:     "negative",
+			duration: "-1.5h",
+			expected: -90 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := duration[interface{}](&ottl.StandardTypeGetter[interface{}, string]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.duration, nil
+				},
+			})
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_DurationError(t *testing.T) {
+	tests := []struct {
+		name     string
+		duration interface{}
+	}{
+		{
+			name:     "empty string",
+			duration: "",
+		},
+		{
+			name:     "missing unit",
+			duration: "10",
+		},
+		{
+			name:     "not a string",
+			duration: int64(10),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := duration[interface{}](&ottl.StandardTypeGetter[interface{}, string]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.duration, nil
+				},
+			})
+			_, err := exprFunc(context.Background(), nil)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ExtractPatternsArguments[K any] struct {
+	Target  ottl.StringGetter[K] `ottlarg:"0"`
+	Pattern string               `ottlarg:"1"`
+}
+
+func NewExtractPatternsFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ExtractPatterns", &ExtractPatternsArguments[K]{}, createExtractPatternsFunction[K])
+}
+
+func createExtractPatternsFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ExtractPatternsArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("ExtractPatternsFactory args must be of type *ExtractPatternsArguments[K]")
+	}
+
+	return extractPatterns(args.Target, args.Pattern)
+}
+
+// extractPatterns returns a `pcommon.Map` with an entry for each named capture group
+// of pattern that participated in the first match against the target string.
+func extractPatterns[K any](target ottl.StringGetter[K], pattern string) (ottl.ExprFunc[K], error) {
+	r, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("the pattern supplied to ExtractPatterns is not a valid pattern: %w", err)
+	}
+
+	namedCaptureGroups := 0
+	for _, groupName := range r.SubexpNames() {
+		if groupName != "" {
+			namedCaptureGroups++
+		}
+	}
+	if namedCaptureGroups == 0 {
+		return nil, fmt.Errorf("at least 1 named capture group must be supplied in the given regex")
+	}
+
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		result := pcommon.NewMap()
+		matches := r.FindStringSubmatchIndex(val)
+		if matches == nil {
+			return result, nil
+		}
+		for i, subexp := range r.SubexpNames() {
+			if subexp == "" || matches[2*i] < 0 {
+				continue
+			}
+			result.PutStr(subexp, val[matches[2*i]:matches[2*i+1]])
+		}
+		return result, nil
+	}, nil
+}
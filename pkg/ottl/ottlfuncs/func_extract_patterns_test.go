@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ExtractPatterns(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		pattern  string
+		expected map[string]any
+	}{
+		{
+			name:     "extract patterns",
+			target:   `a=b c=d`,
+			pattern:  `^a=(?P<a>\w+)\s+c=(?P<c>\w+)$`,
+			expected: map[string]any{"a": "b", "c": "d"},
+		},
+		{
+			name:     "ignore unnamed groups",
+			target:   `GET /health 200`,
+			pattern:  `^(GET|POST) (?P<path>\S+) (?P<status>\d+)$`,
+			expected: map[string]any{"path": "/health", "status": "200"},
+		},
+		{
+			name:     "omit groups that did not participate",
+			target:   `user=jane`,
+			pattern:  `user=(?P<user>\w+)(?: id=(?P<id>\d+))?`,
+			expected: map[string]any{"user": "jane"},
+		},
+		{
+			name:     "keep empty captures",
+			target:   `user=`,
+			pattern:  `user=(?P<user>\w*)`,
+			expected: map[string]any{"user": ""},
+		},
+		{
+			name:     "no match",
+			target:   `a=b`,
+			pattern:  `^c=(?P<c>\w+)$`,
+			expected: map[string]any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardTypeGetter[any, string]{
+				Getter: func(ctx context.Context, tCtx any) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := extractPatterns[any](target, tt.pattern)
+			require.NoError(t, err)
+
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			require.IsType(t, pcommon.Map{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_ExtractPatterns_InvalidPattern(t *testing.T) {
+	target := &ottl.StandardTypeGetter[any, string]{}
+
+	_, err := extractPatterns[any](target, `(?P<a>`)
+	assert.ErrorContains(t, err, "not a valid pattern")
+
+	_, err = extractPatterns[any](target, `^a=(\w+)$`)
+	assert.ErrorContains(t, err, "at least 1 named capture group must be supplied")
+}
+
+func Test_ExtractPatterns_Error(t *testing.T) {
+	target := &ottl.StandardTypeGetter[any, string]{
+		Getter: func(ctx context.Context, tCtx any) (interface{}, error) {
+			return int64(1), nil
+		},
+	}
+	exprFunc, err := extractPatterns[any](target, `(?P<a>\d+)`)
+	require.NoError(t, err)
+
+	_, err = exprFunc(context.Background(), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type FormatTimeArguments[K any] struct {
+	Time   ottl.TimeGetter[K] `ottlarg:"0"`
+	Format string             `ottlarg:"1"`
+}
+
+func NewFormatTimeFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("FormatTime", &FormatTimeArguments[K]{}, createFormatTimeFunction[K])
+}
+
+func createFormatTimeFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*FormatTimeArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("FormatTimeFactory args must be of type *FormatTimeArguments[K]")
+	}
+
+	return formatTime(args.Time, args.Format)
+}
+
+func formatTime[K any](inputTime ottl.TimeGetter[K], format string) (ottl.ExprFunc[K], error) {
+	layout, err := strptimeToGoLayout(format)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		t, err := inputTime.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return t.Format(layout), nil
+	}, nil
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_FormatTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		time     time.Time
+		format   string
+		expected string
+	}{
+		{
+			name:     "short date",
+			time:     time.Date(2023, 4, 12, 10, 45, 30, 123000000, time.UTC),
+			format:   "%Y-%m-%d",
+			expected: "2023-04-12",
+		},
+		{
+			name:     "date and time with milliseconds",
+			time:     time.Date(2023, 4, 12, 10, 45, 30, 123000000, time.UTC),
+			format:   "%FT%T.%L",
+			expected: "2023-04-12T10:45:30.123",
+		},
+		{
+			name:     "names and literal percent",
+			time:     time.Date(2023, 4, 12, 15, 4, 5, 0, time.UTC),
+			format:   "%a %b %e %I%p %%",
+			expected: "Wed Apr 12 03PM %",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := formatTime[interface{}](&ottl.StandardTypeGetter[interface{}, time.Time]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.time, nil
+				},
+			}, tt.format)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_FormatTimeError(t *testing.T) {
+	exprFunc, err := formatTime[interface{}](&ottl.StandardTypeGetter[interface{}, time.Time]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return "2023-04-12", nil
+		},
+	}, "%Y-%m-%d")
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.Error(t, err)
+
+	_, err = formatTime[interface{}](&ottl.StandardTypeGetter[interface{}, time.Time]{}, "%Q")
+	assert.ErrorContains(t, err, "unsupported directive %Q")
+}
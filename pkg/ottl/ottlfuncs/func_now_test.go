@@ -0,0 +1,27 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Now(t *testing.T) {
+	exprFunc, err := now[interface{}]()
+	require.NoError(t, err)
+
+	before := time.Now()
+	value, err := exprFunc(nil, nil)
+	after := time.Now()
+	require.NoError(t, err)
+	require.IsType(t, time.Time{}, value)
+
+	n := value.(time.Time)
+	assert.False(t, n.Before(before))
+	assert.False(t, n.After(after))
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ParseCSVArguments[K any] struct {
+	Target    ottl.StringGetter[K] `ottlarg:"0"`
+	Header    ottl.StringGetter[K] `ottlarg:"1"`
+	Delimiter string               `ottlarg:"2"`
+}
+
+func NewParseCSVFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ParseCSV", &ParseCSVArguments[K]{}, createParseCSVFunction[K])
+}
+
+func createParseCSVFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ParseCSVArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("ParseCSVFactory args must be of type *ParseCSVArguments[K]")
+	}
+
+	return parseCSV(args.Target, args.Header, args.Delimiter)
+}
+
+// parseCSV returns a `pcommon.Map` mapping each field of the header to the field
+// at the same position in the target. Both the header and the target are parsed
+// as a single CSV record using delimiter as the field delimiter.
+func parseCSV[K any](target ottl.StringGetter[K], header ottl.StringGetter[K], delimiter string) (ottl.ExprFunc[K], error) {
+	if utf8.RuneCountInString(delimiter) != 1 {
+		return nil, fmt.Errorf("delimiter must be a single character, got %q", delimiter)
+	}
+	delimiterRune, _ := utf8.DecodeRuneInString(delimiter)
+
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		headerStr, err := header.Get(ctx, tCtx)
+		if err != nil {
+			return nil, fmt.Errorf("error getting header: %w", err)
+		}
+		if headerStr == "" {
+			return nil, fmt.Errorf("header cannot be empty")
+		}
+		targetStr, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, fmt.Errorf("error getting target: %w", err)
+		}
+
+		fields, err := readCSVRecord(headerStr, delimiterRune)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing header: %w", err)
+		}
+		values, err := readCSVRecord(targetStr, delimiterRune)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing target: %w", err)
+		}
+		if len(values) != len(fields) {
+			return nil, fmt.Errorf("wrong number of fields: expected %d, found %d", len(fields), len(values))
+		}
+
+		result := pcommon.NewMap()
+		result.EnsureCapacity(len(fields))
+		for i, field := range fields {
+			result.PutStr(field, values[i])
+		}
+		return result, nil
+	}, nil
+}
+
+// readCSVRecord parses s as exactly one CSV record.
+func readCSVRecord(s string, delimiter rune) ([]string, error) {
+	reader := csv.NewReader(strings.NewReader(s))
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+
+	record, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("no csv record found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err = reader.Read(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("more than one csv record found")
+	}
+	return record, nil
+}
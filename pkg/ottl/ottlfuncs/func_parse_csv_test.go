@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ParseCSV(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		header    string
+		delimiter string
+		expected  map[string]any
+	}{
+		{
+			name:      "comma delimited",
+			target:    "1,jane,GET",
+			header:    "id,user,method",
+			delimiter: ",",
+			expected:  map[string]any{"id": "1", "user": "jane", "method": "GET"},
+		},
+		{
+			name:      "tab delimited",
+			target:    "1\tjane\tGET",
+			header:    "id\tuser\tmethod",
+			delimiter: "\t",
+			expected:  map[string]any{"id": "1", "user": "jane", "method": "GET"},
+		},
+		{
+			name:      "quoted fields",
+			target:    `1,"doe, jane","say ""hi"""`,
+			header:    "id,user,message",
+			delimiter: ",",
+			expected:  map[string]any{"id": "1", "user": "doe, jane", "message": `say "hi"`},
+		},
+		{
+			name:      "quoted field with newline",
+			target:    "1,\"line1\nline2\"",
+			header:    "id,message",
+			delimiter: ",",
+			expected:  map[string]any{"id": "1", "message": "line1\nline2"},
+		},
+		{
+			name:      "empty fields",
+			target:    "1,,",
+			header:    "id,user,method",
+			delimiter: ",",
+			expected:  map[string]any{"id": "1", "user": "", "method": ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardTypeGetter[any, string]{
+				Getter: func(ctx context.Context, tCtx any) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			header := &ottl.StandardTypeGetter[any, string]{
+				Getter: func(ctx context.Context, tCtx any) (interface{}, error) {
+					return tt.header, nil
+				},
+			}
+			exprFunc, err := parseCSV[any](target, header, tt.delimiter)
+			require.NoError(t, err)
+
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			require.IsType(t, pcommon.Map{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_ParseCSV_Error(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        interface{}
+		header        interface{}
+		expectedError string
+	}{
+		{
+			name:          "wrong number of fields",
+			target:        "1,jane",
+			header:        "id,user,method",
+			expectedError: "wrong number of fields: expected 3, found 2",
+		},
+		{
+			name:          "empty target",
+			target:        "",
+			header:        "id,user,method",
+			expectedError: "no csv record found",
+		},
+		{
+			name:          "empty header",
+			target:        "1,jane,GET",
+			header:        "",
+			expectedError: "header cannot be empty",
+		},
+		{
+			name:          "multiple records",
+			target:        "1,jane,GET\n2,john,POST",
+			header:        "id,user,method",
+			expectedError: "more than one csv record found",
+		},
+		{
+			name:          "bare quote",
+			target:        `1,ja"ne,GET`,
+			header:        "id,user,method",
+			expectedError: "error parsing target",
+		},
+		{
+			name:          "target is not a string",
+			target:        int64(1),
+			header:        "id",
+			expectedError: "error getting target",
+		},
+		{
+			name:          "header is not a string",
+			target:        "1",
+			header:        int64(1),
+			expectedError: "error getting header",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardTypeGetter[any, string]{
+				Getter: func(ctx context.Context, tCtx any) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			header := &ottl.StandardTypeGetter[any, string]{
+				Getter: func(ctx context.Context, tCtx any) (interface{}, error) {
+					return tt.header, nil
+				},
+			}
+			exprFunc, err := parseCSV[any](target, header, ",")
+			require.NoError(t, err)
+
+			_, err = exprFunc(context.Background(), nil)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func Test_ParseCSV_InvalidDelimiter(t *testing.T) {
+	target := &ottl.StandardTypeGetter[any, string]{}
+
+	_, err := parseCSV[any](target, target, "")
+	assert.ErrorContains(t, err, "delimiter must be a single character")
+
+	_, err = parseCSV[any](target, target, ",,")
+	assert.ErrorContains(t, err, "delimiter must be a single character")
+}
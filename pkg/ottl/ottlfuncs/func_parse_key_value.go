@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ParseKeyValueArguments[K any] struct {
+	Target        ottl.StringGetter[K]  `ottlarg:"0"`
+	Delimiter     ottl.Optional[string] `ottlarg:"1"`
+	PairDelimiter ottl.Optional[string] `ottlarg:"2"`
+}
+
+func NewParseKeyValueFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ParseKeyValue", &ParseKeyValueArguments[K]{}, createParseKeyValueFunction[K])
+}
+
+func createParseKeyValueFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ParseKeyValueArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("ParseKeyValueFactory args must be of type *ParseKeyValueArguments[K]")
+	}
+
+	return parseKeyValue[K](args.Target, args.Delimiter.GetOr("="), args.PairDelimiter.GetOr(" "))
+}
+
+// parseKeyValue returns a `pcommon.Map` built from the pairs of the target string.
+// Pairs are separated by pairDelimiter and each key is separated from its value by
+// the first occurrence of delimiter. Delimiters inside single or double quotes are
+// ignored and the surrounding quotes are removed from keys and values.
+func parseKeyValue[K any](target ottl.StringGetter[K], delimiter string, pairDelimiter string) (ottl.ExprFunc[K], error) {
+	if delimiter == "" {
+		return nil, fmt.Errorf("delimiter cannot be set to an empty string")
+	}
+	if pairDelimiter == "" {
+		return nil, fmt.Errorf("pair delimiter cannot be set to an empty string")
+	}
+	if delimiter == pairDelimiter {
+		return nil, fmt.Errorf("pair delimiter %q cannot be equal to delimiter %q", pairDelimiter, delimiter)
+	}
+
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		source, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if source == "" {
+			return nil, fmt.Errorf("cannot parse from empty target")
+		}
+
+		pairs, err := splitQuoted(source, pairDelimiter)
+		if err != nil {
+			return nil, fmt.Errorf("error splitting pairs: %w", err)
+		}
+
+		result := pcommon.NewMap()
+		for _, pair := range pairs {
+			pair = strings.TrimSpace(pair)
+			if pair == "" {
+				continue
+			}
+			kv, err := splitQuoted(pair, delimiter)
+			if err != nil {
+				return nil, fmt.Errorf("error splitting pair %q: %w", pair, err)
+			}
+			if len(kv) < 2 {
+				return nil, fmt.Errorf("cannot split %q into 2 items, got %d item(s)", pair, len(kv))
+			}
+			key := unquote(strings.TrimSpace(kv[0]))
+			value := unquote(strings.TrimSpace(strings.Join(kv[1:], delimiter)))
+			result.PutStr(key, value)
+		}
+		return result, nil
+	}, nil
+}
+
+// splitQuoted splits s around each instance of delimiter that is not enclosed in
+// single or double quotes.
+func splitQuoted(s string, delimiter string) ([]string, error) {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case quote != 0:
+			if s[i] == quote {
+				quote = 0
+			}
+		case s[i] == '"' || s[i] == '\'':
+			quote = s[i]
+		case strings.HasPrefix(s[i:], delimiter):
+			parts = append(parts, s[start:i])
+			i += len(delimiter) - 1
+			start = i + 1
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unclosed quote %q in %q", quote, s)
+	}
+	return append(parts, s[start:]), nil
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
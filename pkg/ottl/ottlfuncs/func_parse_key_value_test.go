@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ParseKeyValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		delimiter     string
+		pairDelimiter string
+		expected      map[string]any
+	}{
+		{
+			name:          "simple",
+			target:        "name=test id=1",
+			delimiter:     "=",
+			pairDelimiter: " ",
+			expected:      map[string]any{"name": "test", "id": "1"},
+		},
+		{
+			name:          "custom delimiters",
+			target:        "name:test;id:1;",
+			delimiter:     ":",
+			pairDelimiter: ";",
+			expected:      map[string]any{"name": "test", "id": "1"},
+		},
+		{
+			name:          "multi character delimiters",
+			target:        "name=>test || id=>1",
+			delimiter:     "=>",
+			pairDelimiter: "||",
+			expected:      map[string]any{"name": "test", "id": "1"},
+		},
+		{
+			name:          "repeated pair delimiters",
+			target:        "name=test   id=1",
+			delimiter:     "=",
+			pairDelimiter: " ",
+			expected:      map[string]any{"name": "test", "id": "1"},
+		},
+		{
+			name:          "quoted values",
+			target:        `msg="hello world" user='jane doe' empty=""`,
+			delimiter:     "=",
+			pairDelimiter: " ",
+			expected:      map[string]any{"msg": "hello world", "user": "jane doe", "empty": ""},
+		},
+		{
+			name:          "delimiter in value",
+			target:        "url=http://localhost/?a=b id=1",
+			delimiter:     "=",
+			pairDelimiter: " ",
+			expected:      map[string]any{"url": "http://localhost/?a=b", "id": "1"},
+		},
+		{
+			name:          "duplicate keys keep the last value",
+			target:        "k=1 k=2",
+			delimiter:     "=",
+			pairDelimiter: " ",
+			expected:      map[string]any{"k": "2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardTypeGetter[any, string]{
+				Getter: func(ctx context.Context, tCtx any) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := parseKeyValue[any](target, tt.delimiter, tt.pairDelimiter)
+			require.NoError(t, err)
+
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			require.IsType(t, pcommon.Map{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_ParseKeyValue_Error(t *testing.T) {
+	tests := []struct {
+		name   string
+		target interface{}
+	}{
+		{
+			name:   "empty target",
+			target: "",
+		},
+		{
+			name:   "pair without delimiter",
+			target: "name=test id",
+		},
+		{
+			name:   "unclosed quote",
+			target: `name="test id=1`,
+		},
+		{
+			name:   "not a string",
+			target: int64(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardTypeGetter[any, string]{
+				Getter: func(ctx context.Context, tCtx any) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := parseKeyValue[any](target, "=", " ")
+			require.NoError(t, err)
+
+			_, err = exprFunc(context.Background(), nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_ParseKeyValue_InvalidDelimiters(t *testing.T) {
+	target := &ottl.StandardTypeGetter[any, string]{}
+
+	_, err := parseKeyValue[any](target, "", " ")
+	assert.ErrorContains(t, err, "delimiter cannot be set to an empty string")
+
+	_, err = parseKeyValue[any](target, "=", "")
+	assert.ErrorContains(t, err, "pair delimiter cannot be set to an empty string")
+
+	_, err = parseKeyValue[any](target, "=", "=")
+	assert.ErrorContains(t, err, "cannot be equal to delimiter")
+}
+
+func Test_ParseKeyValue_DefaultDelimiters(t *testing.T) {
+	exprFunc, err := createParseKeyValueFunction[any](ottl.FunctionContext{}, &ParseKeyValueArguments[any]{
+		Target: &ottl.StandardTypeGetter[any, string]{
+			Getter: func(ctx context.Context, tCtx any) (interface{}, error) {
+				return "k1=v1 k2=v2", nil
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	result, err := exprFunc(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"k1": "v1", "k2": "v2"}, result.(pcommon.Map).AsRaw())
+
+	exprFunc, err = createParseKeyValueFunction[any](ottl.FunctionContext{}, &ParseKeyValueArguments[any]{
+		Target: &ottl.StandardTypeGetter[any, string]{
+			Getter: func(ctx context.Context, tCtx any) (interface{}, error) {
+				return "k1=v1,k2=v2", nil
+			},
+		},
+		PairDelimiter: ottl.NewTestingOptional[string](","),
+	})
+	assert.NoError(t, err)
+
+	result, err = exprFunc(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"k1": "v1", "k2": "v2"}, result.(pcommon.Map).AsRaw())
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ParseXMLArguments[K any] struct {
+	Target ottl.StringGetter[K] `ottlarg:"0"`
+}
+
+func NewParseXMLFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ParseXML", &ParseXMLArguments[K]{}, createParseXMLFunction[K])
+}
+
+func createParseXMLFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ParseXMLArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("ParseXMLFactory args must be of type *ParseXMLArguments[K]")
+	}
+
+	return parseXML(args.Target), nil
+}
+
+// parseXML returns a `pcommon.Map` struct that is a result of parsing the target string as XML.
+// Each element is converted into a map with the following keys:
+//
+//	tag        -> the local name of the element
+//	attributes -> map of the element attributes, omitted if there are none
+//	content    -> the trimmed character data of the element, omitted if empty
+//	children   -> slice of the child elements, omitted if there are none
+func parseXML[K any](target ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		decoder := xml.NewDecoder(strings.NewReader(targetVal))
+		var root *xmlElement
+		var stack []*xmlElement
+		for {
+			token, err := decoder.Token()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("unmarshal xml: %w", err)
+			}
+
+			switch t := token.(type) {
+			case xml.StartElement:
+				if len(stack) == 0 && root != nil {
+					return nil, fmt.Errorf("unmarshal xml: found more than one root element")
+				}
+				el := &xmlElement{tag: t.Name.Local, attributes: t.Attr}
+				if len(stack) == 0 {
+					root = el
+				} else {
+					parent := stack[len(stack)-1]
+					parent.children = append(parent.children, el)
+				}
+				stack = append(stack, el)
+			case xml.EndElement:
+				stack = stack[:len(stack)-1]
+			case xml.CharData:
+				if len(stack) > 0 {
+					stack[len(stack)-1].content.Write(t)
+				} else if strings.TrimSpace(string(t)) != "" {
+					return nil, fmt.Errorf("unmarshal xml: found character data outside of the root element")
+				}
+			}
+		}
+		if root == nil {
+			return nil, fmt.Errorf("unmarshal xml: no root element found")
+		}
+
+		result := pcommon.NewMap()
+		root.copyTo(result)
+		return result, nil
+	}
+}
+
+type xmlElement struct {
+	tag        string
+	attributes []xml.Attr
+	content    strings.Builder
+	children   []*xmlElement
+}
+
+func (e *xmlElement) copyTo(m pcommon.Map) {
+	m.PutStr("tag", e.tag)
+	if len(e.attributes) > 0 {
+		attrs := m.PutEmptyMap("attributes")
+		for _, attr := range e.attributes {
+			attrs.PutStr(attr.Name.Local, attr.Value)
+		}
+	}
+	if content := strings.TrimSpace(e.content.String()); content != "" {
+		m.PutStr("content", content)
+	}
+	if len(e.children) > 0 {
+		children := m.PutEmptySlice("children")
+		children.EnsureCapacity(len(e.children))
+		for _, child := range e.children {
+			child.copyTo(children.AppendEmpty().SetEmptyMap())
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ParseXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected map[string]any
+	}{
+		{
+			name:     "empty element",
+			target:   `<Log/>`,
+			expected: map[string]any{"tag": "Log"},
+		},
+		{
+			name:   "attributes and content",
+			target: `<Log level="info" id="1">hello world</Log>`,
+			expected: map[string]any{
+				"tag":        "Log",
+				"attributes": map[string]any{"level": "info", "id": "1"},
+				"content":    "hello world",
+			},
+		},
+		{
+			name: "nested elements",
+			target: `<?xml version="1.0" encoding="UTF-8"?>
+<!-- a comment -->
+<Log>
+  <User id="1">jane</User>
+  <Message>hello</Message>
+  <Tags><Tag>a</Tag><Tag>b</Tag></Tags>
+</Log>`,
+			expected: map[string]any{
+				"tag": "Log",
+				"children": []any{
+					map[string]any{"tag": "User", "attributes": map[string]any{"id": "1"}, "content": "jane"},
+					map[string]any{"tag": "Message", "content": "hello"},
+					map[string]any{"tag": "Tags", "children": []any{
+						map[string]any{"tag": "Tag", "content": "a"},
+						map[string]any{"tag": "Tag", "content": "b"},
+					}},
+				},
+			},
+		},
+		{
+			name:   "cdata and mixed content",
+			target: `<Log>start <![CDATA[<raw>]]> <b>bold</b> end</Log>`,
+			expected: map[string]any{
+				"tag":      "Log",
+				"content":  "start <raw>  end",
+				"children": []any{map[string]any{"tag": "b", "content": "bold"}},
+			},
+		},
+		{
+			name:   "namespaced names",
+			target: `<ns:Log xmlns:ns="urn:test" ns:level="info"/>`,
+			expected: map[string]any{
+				"tag":        "Log",
+				"attributes": map[string]any{"ns": "urn:test", "level": "info"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardTypeGetter[any, string]{
+				Getter: func(ctx context.Context, tCtx any) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc := parseXML[any](target)
+
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			require.IsType(t, pcommon.Map{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_ParseXML_Error(t *testing.T) {
+	tests := []struct {
+		name   string
+		target interface{}
+	}{
+		{
+			name:   "empty target",
+			target: "",
+		},
+		{
+			name:   "unclosed element",
+			target: `<Log><User></Log>`,
+		},
+		{
+			name:   "multiple root elements",
+			target: `<Log/><Log/>`,
+		},
+		{
+			name:   "text outside of the root element",
+			target: `hello <Log/>`,
+		},
+		{
+			name:   "not a string",
+			target: int64(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardTypeGetter[any, string]{
+				Getter: func(ctx context.Context, tCtx any) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc := parseXML[any](target)
+
+			_, err := exprFunc(context.Background(), nil)
+			assert.Error(t, err)
+		})
+	}
+}
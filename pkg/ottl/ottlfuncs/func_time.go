@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type TimeArguments[K any] struct {
+	Time     ottl.StringGetter[K]  `ottlarg:"0"`
+	Format   string                `ottlarg:"1"`
+	Location ottl.Optional[string] `ottlarg:"2"`
+}
+
+func NewTimeFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Time", &TimeArguments[K]{}, createTimeFunction[K])
+}
+
+func createTimeFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*TimeArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("TimeFactory args must be of type *TimeArguments[K]")
+	}
+
+	return parseTime(args.Time, args.Format, args.Location)
+}
+
+func parseTime[K any](inputTime ottl.StringGetter[K], format string, location ottl.Optional[string]) (ottl.ExprFunc[K], error) {
+	layout, err := strptimeToGoLayout(format)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(location.GetOr("UTC"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid location: %w", err)
+	}
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		t, err := inputTime.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if t == "" {
+			return nil, fmt.Errorf("time cannot be empty")
+		}
+		return time.ParseInLocation(layout, t, loc)
+	}, nil
+}
@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Time(t *testing.T) {
+	tests := []struct {
+		name     string
+		time     ottl.StringGetter[interface{}]
+		format   string
+		location ottl.Optional[string]
+		expected time.Time
+	}{
+		{
+			name: "simple short form",
+			time: &ottl.StandardTypeGetter[interface{}, string]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return "2023-04-12", nil
+				},
+			},
+			format:   "%Y-%m-%d",
+			expected: time.Date(2023, 4, 12, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "month day year",
+			time: &ottl.StandardTypeGetter[interface{}, string]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return "04/12/2023", nil
+				},
+			},
+			format:   "%D",
+			expected: time.Date(2023, 4, 12, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "month names and milliseconds",
+			time: &ottl.StandardTypeGetter[interface{}, string]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return "Wednesday, April 12 2023 10:45:30.123", nil
+				},
+			},
+			format:   "%A, %B %d %Y %H:%M:%S.%L",
+			expected: time.Date(2023, 4, 12, 10, 45, 30, 123000000, time.UTC),
+		},
+		{
+			name: "12 hour clock",
+			time: &ottl.StandardTypeGetter[interface{}, string]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return "Apr 12 2023 03:04:05 PM", nil
+				},
+			},
+			format:   "%b %d %Y %r",
+			expected: time.Date(2023, 4, 12, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			name: "utc offset",
+			time: &ottl.StandardTypeGetter[interface{}, string]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return "2023-04-12T10:45:30-0700", nil
+				},
+			},
+			format:   "%FT%T%z",
+			expected: time.Date(2023, 4, 12, 17, 45, 30, 0, time.UTC),
+		},
+		{
+			name: "location",
+			time: &ottl.StandardTypeGetter[interface{}, string]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return "2023-04-12 10:45:30", nil
+				},
+			},
+			format:   "%Y-%m-%d %H:%M:%S",
+			location: ottl.NewTestingOptional[string]("America/New_York"),
+			expected: time.Date(2023, 4, 12, 14, 45, 30, 0, time.UTC),
+		},
+		{
+			name: "utc offset takes precedence over location",
+			time: &ottl.StandardTypeGetter[interface{}, string]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return "2023-04-12T10:45:30-0700", nil
+				},
+			},
+			format:   "%FT%T%z",
+			location: ottl.NewTestingOptional[string]("America/New_York"),
+			expected: time.Date(2023, 4, 12, 17, 45, 30, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := parseTime(tt.time, tt.format, tt.location)
+			require.NoError(t, err)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			require.IsType(t, time.Time{}, result)
+			assert.True(t, tt.expected.Equal(result.(time.Time)), "expected %v, got %v", tt.expected, result)
+		})
+	}
+}
+
+func Test_TimeError(t *testing.T) {
+	tests := []struct {
+		name          string
+		time          string
+		format        string
+		expectedError string
+	}{
+		{
+			name:          "empty time",
+			time:          "",
+			format:        "%Y-%m-%d",
+			expectedError: "time cannot be empty",
+		},
+		{
+			name:          "time does not match format",
+			time:          "12/04/2023",
+			format:        "%Y-%m-%d",
+			expectedError: "cannot parse",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := parseTime[interface{}](&ottl.StandardTypeGetter[interface{}, string]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return tt.time, nil
+				},
+			}, tt.format, ottl.Optional[string]{})
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func Test_TimeFormatError(t *testing.T) {
+	tests := []struct {
+		name          string
+		format        string
+		location      ottl.Optional[string]
+		expectedError string
+	}{
+		{
+			name:          "empty format",
+			format:        "",
+			expectedError: "format cannot be empty",
+		},
+		{
+			name:          "unsupported directive",
+			format:        "%Y-%m-%Q",
+			expectedError: "unsupported directive %Q",
+		},
+		{
+			name:          "incomplete directive",
+			format:        "%Y-%m-%",
+			expectedError: "incomplete directive",
+		},
+		{
+			name:          "fractional seconds without separator",
+			format:        "%Y-%m-%d %H:%M:%S%L",
+			expectedError: "must precede the directive %L with a '.' or ','",
+		},
+		{
+			name:          "digit literal",
+			format:        "%Y-%m-%d 1",
+			expectedError: "can't be told apart from the Go layout elements",
+		},
+		{
+			name:          "invalid location",
+			format:        "%Y-%m-%d",
+			location:      ottl.NewTestingOptional[string]("Not/A_Location"),
+			expectedError: "invalid location",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseTime[interface{}](&ottl.StandardTypeGetter[interface{}, string]{
+				Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+					return "2023-04-12", nil
+				},
+			}, tt.format, tt.location)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_UnixNano(t *testing.T) {
+	exprFunc := unixNano[interface{}](&ottl.StandardTypeGetter[interface{}, time.Time]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return time.Date(2023, 4, 12, 10, 45, 30, 123456789, time.UTC), nil
+		},
+	})
+	result, err := exprFunc(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1681296330123456789), result)
+}
+
+func Test_UnixNanoError(t *testing.T) {
+	exprFunc := unixNano[interface{}](&ottl.StandardTypeGetter[interface{}, time.Time]{
+		Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+			return int64(1681296330), nil
+		},
+	})
+	_, err := exprFunc(context.Background(), nil)
+	assert.Error(t, err)
+}
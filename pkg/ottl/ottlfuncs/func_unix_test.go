@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Unix(t *testing.T) {
+	tests := []struct {
+		name        string
+		seconds     int64
+		nanoseconds int64
+		expected    time.Time
+	}{
+		{
+			name:        "seconds only",
+			seconds:     1681296330,
+			nanoseconds: 0,
+			expected:    time.Date(2023, 4, 12, 10, 45, 30, 0, time.UTC),
+		},
+		{
+			name:        "seconds and nanoseconds",
+			seconds:     1681296330,
+			nanoseconds: 123456789,
+			expected:    time.Date(2023, 4, 12, 10, 45, 30, 123456789, time.UTC),
+		},
+		{
+			name:        "nanoseconds since epoch",
+			seconds:     0,
+			nanoseconds: 1681296330123456789,
+			expected:    time.Date(2023, 4, 12, 10, 45, 30, 123456789, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := unix[interface{}](
+				&ottl.StandardTypeGetter[interface{}, int64]{
+					Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+						return tt.seconds, nil
+					},
+				},
+				&ottl.StandardTypeGetter[interface{}, int64]{
+					Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+						return tt.nanoseconds, nil
+					},
+				},
+			)
+			result, err := exprFunc(nil, nil)
+			require.NoError(t, err)
+			require.IsType(t, time.Time{}, result)
+			assert.True(t, tt.expected.Equal(result.(time.Time)), "expected %v, got %v", tt.expected, result)
+		})
+	}
+}
+
+func Test_UnixError(t *testing.T) {
+	exprFunc := unix[interface{}](
+		&ottl.StandardTypeGetter[interface{}, int64]{
+			Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+				return "1681296330", nil
+			},
+		},
+		&ottl.StandardTypeGetter[interface{}, int64]{
+			Getter: func(ctx context.Context, tCtx interface{}) (interface{}, error) {
+				return int64(0), nil
+			},
+		},
+	)
+	_, err := exprFunc(context.Background(), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	urlOriginal = "url.original"
+	urlScheme   = "url.scheme"
+	urlUserInfo = "url.user_info"
+	urlUsername = "url.username"
+	urlPassword = "url.password"
+	urlDomain   = "url.domain"
+	urlPort     = "url.port"
+	urlPath     = "url.path"
+	urlQuery    = "url.query"
+	urlFragment = "url.fragment"
+)
+
+type URLArguments[K any] struct {
+	Target ottl.StringGetter[K] `ottlarg:"0"`
+}
+
+func NewURLFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("URL", &URLArguments[K]{}, createURLFunction[K])
+}
+
+func createURLFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*URLArguments[K])
+
+	if !ok {
+		return nil, fmt.Errorf("URLFactory args must be of type *URLArguments[K]")
+	}
+
+	return parseURL(args.Target), nil
+}
+
+// parseURL returns a `pcommon.Map` with the components of the target URL, using the
+// `url.*` semantic convention attribute names. Components that are not set are omitted.
+func parseURL[K any](target ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (interface{}, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if targetVal == "" {
+			return nil, fmt.Errorf("url cannot be empty")
+		}
+
+		u, err := url.Parse(targetVal)
+		if err != nil {
+			return nil, err
+		}
+
+		result := pcommon.NewMap()
+		result.PutStr(urlOriginal, targetVal)
+		putNonEmpty(result, urlScheme, u.Scheme)
+		if u.User != nil {
+			result.PutStr(urlUserInfo, u.User.String())
+			putNonEmpty(result, urlUsername, u.User.Username())
+			if password, ok := u.User.Password(); ok {
+				result.PutStr(urlPassword, password)
+			}
+		}
+		putNonEmpty(result, urlDomain, u.Hostname())
+		if port := u.Port(); port != "" {
+			p, err := strconv.ParseInt(port, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid port %q: %w", port, err)
+			}
+			result.PutInt(urlPort, p)
+		}
+		putNonEmpty(result, urlPath, u.EscapedPath())
+		putNonEmpty(result, urlQuery, u.RawQuery)
+		putNonEmpty(result, urlFragment, u.Fragment)
+		return result, nil
+	}
+}
+
+func putNonEmpty(m pcommon.Map, key string, value string) {
+	if value != "" {
+		m.PutStr(key, value)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+	"time"
+)
+
+// strptimeDirectives maps the supported strptime directives to their Go layout equivalent.
+var strptimeDirectives = map[byte]string{
+	'Y': "2006",                     // Year, zero-padded (0001, 0002, ..., 2019, 2020, ..., 9999)
+	'y': "06",                       // Year, last two digits, zero-padded (01, ..., 99)
+	'm': "01",                       // Month as a decimal number (01, 02, ..., 12)
+	'o': "_1",                       // Month as a space-padded number ( 1, 2, ..., 12)
+	'q': "1",                        // Month as a unpadded number (1,2,...,12)
+	'b': "Jan",                      // Abbreviated month name (Jan, Feb, ...)
+	'h': "Jan",                      // Abbreviated month name (Jan, Feb, ...)
+	'B': "January",                  // Full month name (January, February, ...)
+	'd': "02",                       // Day of the month, zero-padded (01, 02, ..., 31)
+	'e': "_2",                       // Day of the month, space-padded ( 1, 2, ..., 31)
+	'g': "2",                        // Day of the month, unpadded (1,2,...,31)
+	'a': "Mon",                      // Abbreviated weekday name (Sun, Mon, ...)
+	'A': "Monday",                   // Full weekday name (Sunday, Monday, ...)
+	'H': "15",                       // Hour (24-hour clock) as a zero-padded decimal number (00, ..., 24)
+	'l': "3",                        // Hour (12-hour clock: 0, ..., 12)
+	'I': "03",                       // Hour (12-hour clock) as a zero-padded decimal number (00, ..., 12)
+	'p': "PM",                       // Locale’s equivalent of either AM or PM
+	'P': "pm",                       // Locale’s equivalent of either am or pm
+	'M': "04",                       // Minute, zero-padded (00, 01, ..., 59)
+	'S': "05",                       // Second as a zero-padded decimal number (00, 01, ..., 59)
+	'L': "000",                      // Millisecond as a decimal number, zero-padded on the left (000, 001, ..., 999)
+	'f': "000000",                   // Microsecond as a decimal number, zero-padded on the left (000000, ..., 999999)
+	's': "000000000",                // Nanosecond as a decimal number, zero-padded on the left (000000000, ..., 999999999)
+	'Z': "MST",                      // Timezone name or abbreviation or empty (UTC, EST, CST)
+	'z': "-0700",                    // UTC offset in the form +HHMM or -HHMM (+0000, -0400, +1030, ...)
+	'i': "-07",                      // UTC offset in the form +HH or -HH (+00, -04, +10, ...)
+	'j': "-07:00",                   // UTC offset in the form +HH:MM or -HH:MM (+00:00, -04:00, +10:30, ...)
+	'k': "-07:00:00",                // UTC offset in the form +HH:MM:SS or -HH:MM:SS (+00:00:00, -04:00:00, ...)
+	'D': "01/02/2006",               // Short MM/DD/YYYY date, equivalent to %m/%d/%Y
+	'F': "2006-01-02",               // Short YYYY-MM-DD date, equivalent to %Y-%m-%d
+	'T': "15:04:05",                 // ISO 8601 time format (HH:MM:SS), equivalent to %H:%M:%S
+	'r': "03:04:05 PM",              // 12-hour clock time, equivalent to %I:%M:%S %p
+	'R': "15:04",                    // 24-hour HH:MM time, equivalent to %H:%M
+	'c': "Mon Jan _2 15:04:05 2006", // Date and time representation, equivalent to %a %b %e %H:%M:%S %Y
+	'x': "01/02/06",                 // Date representation, equivalent to %m/%d/%y
+	'X': "15:04:05",                 // Time representation, equivalent to %H:%M:%S
+	'%': "%",                        // A literal '%' character
+}
+
+// fractionalDirectives are the directives of fractional seconds, which the
+// Go time package only recognizes right after a '.' or ',' separator.
+var fractionalDirectives = map[byte]bool{'L': true, 's': true, 'f': true}
+
+// layoutCheckTime is formatted to detect text of a strptime format that the Go
+// time package would read as a different layout element than intended, such as
+// literal text matching an element. None of its fields format to the same text
+// as the layout elements that represent them.
+var layoutCheckTime = time.Date(1999, time.November, 28, 7, 48, 37, 123456789, time.FixedZone("XYZ", 5*60*60+30*60))
+
+// strptimeToGoLayout converts a strptime format, made of the directives listed
+// in strptimeDirectives, to the layout used by the Go time package.
+// Since the Go layout can't escape literal text, formats whose literal text
+// would be read as part of a layout element are rejected.
+func strptimeToGoLayout(format string) (string, error) {
+	if format == "" {
+		return "", fmt.Errorf("format cannot be empty")
+	}
+	// expected is layoutCheckTime formatted with each directive on its own,
+	// which the whole layout must match when its elements are read as intended.
+	var layout, expected []byte
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			layout = append(layout, format[i])
+			expected = append(expected, format[i])
+			continue
+		}
+		i++
+		if i >= len(format) {
+			return "", fmt.Errorf("format %q ends with an incomplete directive", format)
+		}
+		directive, ok := strptimeDirectives[format[i]]
+		if !ok {
+			return "", fmt.Errorf("format %q contains the unsupported directive %%%c", format, format[i])
+		}
+		if fractionalDirectives[format[i]] {
+			if len(layout) == 0 || (layout[len(layout)-1] != '.' && layout[len(layout)-1] != ',') {
+				return "", fmt.Errorf("format %q must precede the directive %%%c with a '.' or ','", format, format[i])
+			}
+			// The separator is part of the fractional seconds element.
+			separator := string(layout[len(layout)-1])
+			expected = expected[:len(expected)-1]
+			expected = append(expected, layoutCheckTime.Format(separator+directive)...)
+		} else {
+			expected = append(expected, layoutCheckTime.Format(directive)...)
+		}
+		layout = append(layout, directive...)
+	}
+	if layoutCheckTime.Format(string(layout)) != string(expected) {
+		return "", fmt.Errorf("format %q contains text that can't be told apart from the Go layout elements", format)
+	}
+	return string(layout), nil
+}
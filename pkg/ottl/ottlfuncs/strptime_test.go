@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_strptimeToGoLayout(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		expected string
+	}{
+		{
+			name:     "date",
+			format:   "%Y-%m-%d",
+			expected: "2006-01-02",
+		},
+		{
+			name:     "milliseconds after a dot",
+			format:   "%H:%M:%S.%L",
+			expected: "15:04:05.000",
+		},
+		{
+			name:     "microseconds after a comma",
+			format:   "%T,%f",
+			expected: "15:04:05,000000",
+		},
+		{
+			name:     "adjacent directives",
+			format:   "%Y%m%d%H%M%S",
+			expected: "20060102150405",
+		},
+		{
+			name:     "literal text",
+			format:   "day %d of %B, at %Hh",
+			expected: "day 02 of January, at 15h",
+		},
+		{
+			name:     "literal percent",
+			format:   "%d%%",
+			expected: "02%",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layout, err := strptimeToGoLayout(tt.format)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, layout)
+		})
+	}
+}
+
+func Test_strptimeToGoLayout_Error(t *testing.T) {
+	tests := []struct {
+		name          string
+		format        string
+		expectedError string
+	}{
+		{
+			name:          "milliseconds right after the seconds",
+			format:        "%S%L",
+			expectedError: "must precede the directive %L with a '.' or ','",
+		},
+		{
+			name:          "nanoseconds at the start",
+			format:        "%s",
+			expectedError: "must precede the directive %s with a '.' or ','",
+		},
+		{
+			name:          "digit literal",
+			format:        "%Y-%m-%d 1",
+			expectedError: "can't be told apart from the Go layout elements",
+		},
+		{
+			name:          "month name literal",
+			format:        "%d Jan %Y",
+			expectedError: "can't be told apart from the Go layout elements",
+		},
+		{
+			name:          "timezone literal",
+			format:        "%T MST",
+			expectedError: "can't be told apart from the Go layout elements",
+		},
+		{
+			name:          "meridiem literal",
+			format:        "%I PM",
+			expectedError: "can't be told apart from the Go layout elements",
+		},
+		{
+			name:          "literal extending a directive",
+			format:        "%buary",
+			expectedError: "can't be told apart from the Go layout elements",
+		},
+		{
+			name:          "underscore before a day",
+			format:        "_%e",
+			expectedError: "can't be told apart from the Go layout elements",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := strptimeToGoLayout(tt.format)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
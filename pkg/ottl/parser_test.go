@@ -33,9 +33,11 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							String: ottltest.Strp("foo"),
+							Value: value{
+								String: ottltest.Strp("foo"),
+							},
 						},
 					},
 				},
@@ -48,10 +50,12 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "met",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Float: ottltest.Floatp(1.2),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Float: ottltest.Floatp(1.2),
+								},
 							},
 						},
 					},
@@ -65,10 +69,12 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "fff",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Int: ottltest.Intp(12),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Int: ottltest.Intp(12),
+								},
 							},
 						},
 					},
@@ -82,24 +88,30 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							String: ottltest.Strp("foo"),
+							Value: value{
+								String: ottltest.Strp("foo"),
+							},
 						},
 						{
-							Literal: &mathExprLiteral{
-								Converter: &converter{
-									Function: "GetSomething",
-									Arguments: []value{
-										{
-											Literal: &mathExprLiteral{
-												Path: &Path{
-													Fields: []Field{
-														{
-															Name: "bear",
-														},
-														{
-															Name: "honey",
+							Value: value{
+								Literal: &mathExprLiteral{
+									Converter: &converter{
+										Function: "GetSomething",
+										Arguments: []argument{
+											{
+												Value: value{
+													Literal: &mathExprLiteral{
+														Path: &Path{
+															Fields: []Field{
+																{
+																	Name: "bear",
+																},
+																{
+																	Name: "honey",
+																},
+															},
 														},
 													},
 												},
@@ -120,31 +132,35 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Path: &Path{
-									Fields: []Field{
-										{
-											Name: "foo",
-										},
-										{
-											Name: "attributes",
-											Keys: []Key{
-												{
-													String: ottltest.Strp("bar"),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "foo",
+											},
+											{
+												Name: "attributes",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("bar"),
+													},
 												},
 											},
-										},
-										{
-											Name: "cat",
+											{
+												Name: "cat",
+											},
 										},
 									},
 								},
 							},
 						},
 						{
-							String: ottltest.Strp("dog"),
+							Value: value{
+								String: ottltest.Strp("dog"),
+							},
 						},
 					},
 				},
@@ -157,42 +173,46 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Path: &Path{
-									Fields: []Field{
-										{
-											Name: "foo",
-										},
-										{
-											Name: "bar",
-											Keys: []Key{
-												{
-													String: ottltest.Strp("x"),
-												},
-												{
-													String: ottltest.Strp("y"),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "foo",
+											},
+											{
+												Name: "bar",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("x"),
+													},
+													{
+														String: ottltest.Strp("y"),
+													},
 												},
 											},
-										},
-										{
-											Name: "z",
+											{
+												Name: "z",
+											},
 										},
 									},
 								},
 							},
 						},
 						{
-							Literal: &mathExprLiteral{
-								Converter: &converter{
-									Function: "Test",
-									Keys: []Key{
-										{
-											Int: ottltest.Intp(0),
-										},
-										{
-											String: ottltest.Strp("pass"),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Converter: &converter{
+										Function: "Test",
+										Keys: []Key{
+											{
+												Int: ottltest.Intp(0),
+											},
+											{
+												String: ottltest.Strp("pass"),
+											},
 										},
 									},
 								},
@@ -209,31 +229,35 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Path: &Path{
-									Fields: []Field{
-										{
-											Name: "foo",
-										},
-										{
-											Name: "attributes",
-											Keys: []Key{
-												{
-													String: ottltest.Strp("bar"),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "foo",
+											},
+											{
+												Name: "attributes",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("bar"),
+													},
 												},
 											},
-										},
-										{
-											Name: "cat",
+											{
+												Name: "cat",
+											},
 										},
 									},
 								},
 							},
 						},
 						{
-							String: ottltest.Strp("dog"),
+							Value: value{
+								String: ottltest.Strp("dog"),
+							},
 						},
 					},
 				},
@@ -268,31 +292,35 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Path: &Path{
-									Fields: []Field{
-										{
-											Name: "foo",
-										},
-										{
-											Name: "attributes",
-											Keys: []Key{
-												{
-													String: ottltest.Strp("bar"),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "foo",
+											},
+											{
+												Name: "attributes",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("bar"),
+													},
 												},
 											},
-										},
-										{
-											Name: "cat",
+											{
+												Name: "cat",
+											},
 										},
 									},
 								},
 							},
 						},
 						{
-							String: ottltest.Strp("dog"),
+							Value: value{
+								String: ottltest.Strp("dog"),
+							},
 						},
 					},
 				},
@@ -327,31 +355,35 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Path: &Path{
-									Fields: []Field{
-										{
-											Name: "foo",
-										},
-										{
-											Name: "attributes",
-											Keys: []Key{
-												{
-													String: ottltest.Strp("bar"),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "foo",
+											},
+											{
+												Name: "attributes",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("bar"),
+													},
 												},
 											},
-										},
-										{
-											Name: "cat",
+											{
+												Name: "cat",
+											},
 										},
 									},
 								},
 							},
 						},
 						{
-							String: ottltest.Strp("dog"),
+							Value: value{
+								String: ottltest.Strp("dog"),
+							},
 						},
 					},
 				},
@@ -386,9 +418,11 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							String: ottltest.Strp("fo\"o"),
+							Value: value{
+								String: ottltest.Strp("fo\"o"),
+							},
 						},
 					},
 				},
@@ -401,12 +435,16 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "convert_gauge_to_sum",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							String: ottltest.Strp("cumulative"),
+							Value: value{
+								String: ottltest.Strp("cumulative"),
+							},
 						},
 						{
-							Bool: (*boolean)(ottltest.Boolp(false)),
+							Value: value{
+								Bool: (*boolean)(ottltest.Boolp(false)),
+							},
 						},
 					},
 				},
@@ -419,12 +457,16 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "convert_gauge_to_sum",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							String: ottltest.Strp("cumulative"),
+							Value: value{
+								String: ottltest.Strp("cumulative"),
+							},
 						},
 						{
-							Bool: (*boolean)(ottltest.Boolp(true)),
+							Value: value{
+								Bool: (*boolean)(ottltest.Boolp(true)),
+							},
 						},
 					},
 				},
@@ -437,16 +479,18 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Path: &Path{
-									Fields: []Field{
-										{
-											Name: "attributes",
-											Keys: []Key{
-												{
-													String: ottltest.Strp("bytes"),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "attributes",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("bytes"),
+													},
 												},
 											},
 										},
@@ -455,7 +499,9 @@ func Test_parse(t *testing.T) {
 							},
 						},
 						{
-							Bytes: (*byteSlice)(&[]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+							Value: value{
+								Bytes: (*byteSlice)(&[]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+							},
 						},
 					},
 				},
@@ -468,16 +514,18 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Path: &Path{
-									Fields: []Field{
-										{
-											Name: "attributes",
-											Keys: []Key{
-												{
-													String: ottltest.Strp("test"),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "attributes",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("test"),
+													},
 												},
 											},
 										},
@@ -486,7 +534,9 @@ func Test_parse(t *testing.T) {
 							},
 						},
 						{
-							IsNil: (*isNil)(ottltest.Boolp(true)),
+							Value: value{
+								IsNil: (*isNil)(ottltest.Boolp(true)),
+							},
 						},
 					},
 				},
@@ -499,25 +549,125 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
+						{
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "attributes",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("test"),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							Value: value{
+								Enum: (*EnumSymbol)(ottltest.Strp("TEST_ENUM")),
+							},
+						},
+					},
+				},
+				WhereClause: nil,
+			},
+		},
+		{
+			name:      "editor with map",
+			statement: `set(attributes["test"], {"foo": "bar", "list": [1], "map": {}})`,
+			expected: &parsedStatement{
+				Editor: editor{
+					Function: "set",
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Path: &Path{
-									Fields: []Field{
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "attributes",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("test"),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							Value: value{
+								Map: &mapValue{
+									Values: []mapItem{
 										{
-											Name: "attributes",
-											Keys: []Key{
-												{
-													String: ottltest.Strp("test"),
+											Key: ottltest.Strp("foo"),
+											Value: &value{
+												String: ottltest.Strp("bar"),
+											},
+										},
+										{
+											Key: ottltest.Strp("list"),
+											Value: &value{
+												List: &list{
+													Values: []value{
+														{
+															Literal: &mathExprLiteral{
+																Int: ottltest.Intp(1),
+															},
+														},
+													},
 												},
 											},
 										},
+										{
+											Key: ottltest.Strp("map"),
+											Value: &value{
+												Map: &mapValue{},
+											},
+										},
 									},
 								},
 							},
 						},
+					},
+				},
+				WhereClause: nil,
+			},
+		},
+		{
+			name:      "editor with named argument",
+			statement: `set(target=name, value="test")`,
+			expected: &parsedStatement{
+				Editor: editor{
+					Function: "set",
+					Arguments: []argument{
 						{
-							Enum: (*EnumSymbol)(ottltest.Strp("TEST_ENUM")),
+							Name: "target",
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "name",
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							Name: "value",
+							Value: value{
+								String: ottltest.Strp("test"),
+							},
 						},
 					},
 				},
@@ -530,16 +680,18 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Path: &Path{
-									Fields: []Field{
-										{
-											Name: "attributes",
-											Keys: []Key{
-												{
-													String: ottltest.Strp("test"),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "attributes",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("test"),
+													},
 												},
 											},
 										},
@@ -548,8 +700,10 @@ func Test_parse(t *testing.T) {
 							},
 						},
 						{
-							List: &list{
-								Values: nil,
+							Value: value{
+								List: &list{
+									Values: nil,
+								},
 							},
 						},
 					},
@@ -563,16 +717,18 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Path: &Path{
-									Fields: []Field{
-										{
-											Name: "attributes",
-											Keys: []Key{
-												{
-													String: ottltest.Strp("test"),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "attributes",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("test"),
+													},
 												},
 											},
 										},
@@ -581,10 +737,12 @@ func Test_parse(t *testing.T) {
 							},
 						},
 						{
-							List: &list{
-								Values: []value{
-									{
-										String: ottltest.Strp("value0"),
+							Value: value{
+								List: &list{
+									Values: []value{
+										{
+											String: ottltest.Strp("value0"),
+										},
 									},
 								},
 							},
@@ -600,16 +758,18 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Path: &Path{
-									Fields: []Field{
-										{
-											Name: "attributes",
-											Keys: []Key{
-												{
-													String: ottltest.Strp("test"),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "attributes",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("test"),
+													},
 												},
 											},
 										},
@@ -618,13 +778,15 @@ func Test_parse(t *testing.T) {
 							},
 						},
 						{
-							List: &list{
-								Values: []value{
-									{
-										String: ottltest.Strp("value1"),
-									},
-									{
-										String: ottltest.Strp("value2"),
+							Value: value{
+								List: &list{
+									Values: []value{
+										{
+											String: ottltest.Strp("value1"),
+										},
+										{
+											String: ottltest.Strp("value2"),
+										},
 									},
 								},
 							},
@@ -640,16 +802,18 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Path: &Path{
-									Fields: []Field{
-										{
-											Name: "attributes",
-											Keys: []Key{
-												{
-													String: ottltest.Strp("test"),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "attributes",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("test"),
+													},
 												},
 											},
 										},
@@ -658,63 +822,69 @@ func Test_parse(t *testing.T) {
 							},
 						},
 						{
-							List: &list{
-								Values: []value{
-									{
-										Literal: &mathExprLiteral{
-											Converter: &converter{
-												Function: "Concat",
-												Arguments: []value{
-													{
-														List: &list{
-															Values: []value{
-																{
-																	String: ottltest.Strp("a"),
-																},
-																{
-																	String: ottltest.Strp("b"),
+							Value: value{
+								List: &list{
+									Values: []value{
+										{
+											Literal: &mathExprLiteral{
+												Converter: &converter{
+													Function: "Concat",
+													Arguments: []argument{
+														{
+															Value: value{
+																List: &list{
+																	Values: []value{
+																		{
+																			String: ottltest.Strp("a"),
+																		},
+																		{
+																			String: ottltest.Strp("b"),
+																		},
+																	},
 																},
 															},
 														},
-													},
-													{
-														String: ottltest.Strp("+"),
+														{
+															Value: value{
+																String: ottltest.Strp("+"),
+															},
+														},
 													},
 												},
 											},
 										},
-									},
-									{
-										List: &list{
-											Values: []value{
-												{
-													String: ottltest.Strp("1"),
-												},
-												{
-													Literal: &mathExprLiteral{
-														Int: ottltest.Intp(2),
+										{
+											List: &list{
+												Values: []value{
+													{
+														String: ottltest.Strp("1"),
 													},
-												},
-												{
-													Literal: &mathExprLiteral{
-														Float: ottltest.Floatp(3.0),
+													{
+														Literal: &mathExprLiteral{
+															Int: ottltest.Intp(2),
+														},
+													},
+													{
+														Literal: &mathExprLiteral{
+															Float: ottltest.Floatp(3.0),
+														},
 													},
 												},
 											},
 										},
-									},
-									{
-										IsNil: (*isNil)(ottltest.Boolp(true)),
-									},
-									{
-										Literal: &mathExprLiteral{
-											Path: &Path{
-												Fields: []Field{
-													{
-														Name: "attributes",
-														Keys: []Key{
-															{
-																String: ottltest.Strp("test"),
+										{
+											IsNil: (*isNil)(ottltest.Boolp(true)),
+										},
+										{
+											Literal: &mathExprLiteral{
+												Path: &Path{
+													Fields: []Field{
+														{
+															Name: "attributes",
+															Keys: []Key{
+																{
+																	String: ottltest.Strp("test"),
+																},
 															},
 														},
 													},
@@ -736,16 +906,18 @@ func Test_parse(t *testing.T) {
 			expected: &parsedStatement{
 				Editor: editor{
 					Function: "set",
-					Arguments: []value{
+					Arguments: []argument{
 						{
-							Literal: &mathExprLiteral{
-								Path: &Path{
-									Fields: []Field{
-										{
-											Name: "attributes",
-											Keys: []Key{
-												{
-													String: ottltest.Strp("test"),
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "attributes",
+												Keys: []Key{
+													{
+														String: ottltest.Strp("test"),
+													},
 												},
 											},
 										},
@@ -754,21 +926,23 @@ func Test_parse(t *testing.T) {
 							},
 						},
 						{
-							MathExpression: &mathExpression{
-								Left: &addSubTerm{
-									Left: &mathValue{
-										Literal: &mathExprLiteral{
-											Int: ottltest.Intp(1000),
+							Value: value{
+								MathExpression: &mathExpression{
+									Left: &addSubTerm{
+										Left: &mathValue{
+											Literal: &mathExprLiteral{
+												Int: ottltest.Intp(1000),
+											},
 										},
 									},
-								},
-								Right: []*opAddSubTerm{
-									{
-										Operator: SUB,
-										Term: &addSubTerm{
-											Left: &mathValue{
-												Literal: &mathExprLiteral{
-													Int: ottltest.Intp(600),
+									Right: []*opAddSubTerm{
+										{
+											Operator: SUB,
+											Term: &addSubTerm{
+												Left: &mathValue{
+													Literal: &mathExprLiteral{
+														Int: ottltest.Intp(600),
+													},
 												},
 											},
 										},
@@ -883,20 +1057,24 @@ func setNameTest(b *booleanExpression) *parsedStatement {
 	return &parsedStatement{
 		Editor: editor{
 			Function: "set",
-			Arguments: []value{
+			Arguments: []argument{
 				{
-					Literal: &mathExprLiteral{
-						Path: &Path{
-							Fields: []Field{
-								{
-									Name: "name",
+					Value: value{
+						Literal: &mathExprLiteral{
+							Path: &Path{
+								Fields: []Field{
+									{
+										Name: "name",
+									},
 								},
 							},
 						},
 					},
 				},
 				{
-					String: ottltest.Strp("test"),
+					Value: value{
+						String: ottltest.Strp("test"),
+					},
 				},
 			},
 		},
@@ -1422,6 +1600,14 @@ func Test_parseStatement(t *testing.T) {
 		{`test() where one() == 1`, true},
 		{`test(fail())`, true},
 		{`Test()`, true},
+		{`set(attributes["test"], {"foo": "bar", "baz": 1})`, false},
+		{`set(attributes["test"], {})`, false},
+		{`set(attributes["test"], {"foo"})`, true},
+		{`set(attributes["test"], {foo: "bar"})`, true},
+		{`set(attributes["test"], {"foo": "bar",})`, true},
+		{`set(attributes["test"], value=Concat(["a"], delimiter=""))`, false},
+		{`set(attributes["test"], value="bar"`, true},
+		{`set(attributes["test"], value=)`, true},
 	}
 	pat := regexp.MustCompile("[^a-zA-Z0-9]+")
 	for _, tt := range tests {
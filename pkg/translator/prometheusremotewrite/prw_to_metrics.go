@@ -0,0 +1,613 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewrite // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/prompb"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/multierr"
+)
+
+const totalSuffix = "_total"
+
+// maxNativeHistogramBuckets caps the range of buckets a single native histogram span layout may
+// cover. The largest schema needs about 2^19 buckets to cover every float64, so anything above
+// this is a malformed request and must not be padded into a dense slice.
+const maxNativeHistogramBuckets = 1 << 20
+
+// familyKind is the kind of metric a family of prometheus series is converted to.
+type familyKind int
+
+const (
+	gaugeFamily familyKind = iota
+	counterFamily
+	histogramFamily
+	summaryFamily
+	nativeHistogramFamily
+)
+
+// ToMetrics converts a prometheus remote write request to pmetric.Metrics.
+//
+// The series are grouped into resources by their job and instance labels, which are mapped back to
+// service.namespace, service.name and service.instance.id, and the labels of the target_info series
+// become the attributes of the resource with the same job and instance. The kind of each metric comes
+// from the metadata of the request when available, and is otherwise inferred from its series:
+//   - series with native histogram samples are converted to exponential histograms,
+//   - the _bucket, _sum and _count series of a family with le labels to histograms,
+//   - the series with quantile labels, and the _sum and _count series of their family, to summaries,
+//   - the _total series to monotonic cumulative sums,
+//   - and all the other series to gauges.
+//
+// The series that can't be converted are skipped, and reported in the returned error.
+func ToMetrics(req *prompb.WriteRequest) (pmetric.Metrics, error) {
+	b := newMetricsBuilder(req)
+	var errs error
+	for i := range req.Timeseries {
+		errs = multierr.Append(errs, b.addSeries(&req.Timeseries[i]))
+	}
+	return b.build(), errs
+}
+
+// seriesLabels holds the labels of a series, split by their meaning.
+type seriesLabels struct {
+	name     string
+	job      string
+	instance string
+	le       string
+	quantile string
+	// attributes are the remaining labels, sorted by name
+	attributes []prompb.Label
+}
+
+func newSeriesLabels(labels []prompb.Label) seriesLabels {
+	var sl seriesLabels
+	for _, l := range labels {
+		switch l.Name {
+		case model.MetricNameLabel:
+			sl.name = l.Value
+		case model.JobLabel:
+			sl.job = l.Value
+		case model.InstanceLabel:
+			sl.instance = l.Value
+		case leStr:
+			sl.le = l.Value
+		case quantileStr:
+			sl.quantile = l.Value
+		default:
+			sl.attributes = append(sl.attributes, l)
+		}
+	}
+	sort.Stable(ByLabelName(sl.attributes))
+	return sl
+}
+
+// signature identifies the attributes of the series.
+func (sl seriesLabels) signature() string {
+	var b strings.Builder
+	for _, l := range sl.attributes {
+		b.WriteString(l.Name)
+		b.WriteByte(0)
+		b.WriteString(l.Value)
+		b.WriteByte(0)
+	}
+	return b.String()
+}
+
+func (sl seriesLabels) copyAttributesTo(attrs pcommon.Map) {
+	attrs.EnsureCapacity(len(sl.attributes))
+	for _, l := range sl.attributes {
+		attrs.PutStr(l.Name, l.Value)
+	}
+}
+
+type metricsBuilder struct {
+	metadata map[string]prompb.MetricMetadata
+	kinds    map[string]familyKind
+
+	resources     []*resourceBuilder
+	resourceByKey map[string]*resourceBuilder
+}
+
+func newMetricsBuilder(req *prompb.WriteRequest) *metricsBuilder {
+	b := &metricsBuilder{
+		metadata:      make(map[string]prompb.MetricMetadata, len(req.Metadata)),
+		kinds:         make(map[string]familyKind),
+		resourceByKey: make(map[string]*resourceBuilder),
+	}
+	for _, md := range req.Metadata {
+		b.metadata[md.MetricFamilyName] = md
+		switch md.Type {
+		case prompb.MetricMetadata_COUNTER:
+			b.kinds[md.MetricFamilyName] = counterFamily
+		case prompb.MetricMetadata_HISTOGRAM, prompb.MetricMetadata_GAUGEHISTOGRAM:
+			b.kinds[md.MetricFamilyName] = histogramFamily
+		case prompb.MetricMetadata_SUMMARY:
+			b.kinds[md.MetricFamilyName] = summaryFamily
+		default:
+			b.kinds[md.MetricFamilyName] = gaugeFamily
+		}
+	}
+
+	// infer the kind of the families the request has no metadata for
+	for i := range req.Timeseries {
+		sl := newSeriesLabels(req.Timeseries[i].Labels)
+		switch {
+		case sl.le != "" && strings.HasSuffix(sl.name, bucketStr):
+			b.inferKind(strings.TrimSuffix(sl.name, bucketStr), histogramFamily)
+		case sl.quantile != "":
+			b.inferKind(sl.name, summaryFamily)
+		}
+	}
+	return b
+}
+
+func (b *metricsBuilder) inferKind(family string, kind familyKind) {
+	if _, ok := b.kinds[family]; !ok {
+		b.kinds[family] = kind
+	}
+}
+
+// family returns the name and kind of the family the series belongs to.
+func (b *metricsBuilder) family(ts *prompb.TimeSeries, sl seriesLabels) (string, familyKind) {
+	if len(ts.Histograms) > 0 {
+		return sl.name, nativeHistogramFamily
+	}
+	for _, suffix := range []string{bucketStr, sumStr, countStr} {
+		base := strings.TrimSuffix(sl.name, suffix)
+		if base == sl.name {
+			continue
+		}
+		switch b.kinds[base] {
+		case histogramFamily:
+			return base, histogramFamily
+		case summaryFamily:
+			if suffix != bucketStr {
+				return base, summaryFamily
+			}
+		}
+	}
+	kind, ok := b.kinds[sl.name]
+	switch {
+	case ok && kind == counterFamily:
+		return sl.name, counterFamily
+	case ok && kind == summaryFamily && sl.quantile != "":
+		return sl.name, summaryFamily
+	case !ok && strings.HasSuffix(sl.name, totalSuffix):
+		// the metadata of counters may be reported without the _total suffix
+		if kind, ok := b.kinds[strings.TrimSuffix(sl.name, totalSuffix)]; !ok || kind == counterFamily {
+			return sl.name, counterFamily
+		}
+	}
+	return sl.name, gaugeFamily
+}
+
+// metadataFor returns the metadata of the family, which may be reported without the _total suffix.
+func (b *metricsBuilder) metadataFor(family string) prompb.MetricMetadata {
+	if md, ok := b.metadata[family]; ok {
+		return md
+	}
+	return b.metadata[strings.TrimSuffix(family, totalSuffix)]
+}
+
+func (b *metricsBuilder) resource(sl seriesLabels) *resourceBuilder {
+	key := sl.job + "\x00" + sl.instance
+	if rb, ok := b.resourceByKey[key]; ok {
+		return rb
+	}
+	rb := newResourceBuilder(sl.job, sl.instance)
+	b.resources = append(b.resources, rb)
+	b.resourceByKey[key] = rb
+	return rb
+}
+
+func (b *metricsBuilder) addSeries(ts *prompb.TimeSeries) error {
+	sl := newSeriesLabels(ts.Labels)
+	if sl.name == "" {
+		return errors.New("time series without a metric name")
+	}
+
+	rb := b.resource(sl)
+	if sl.name == targetMetricName {
+		// the target info metric only carries the attributes of the resource
+		sl.copyAttributesTo(rb.resource.Attributes())
+		return nil
+	}
+
+	family, kind := b.family(ts, sl)
+	metric := rb.metric(family, kind, b.metadataFor(family))
+	switch kind {
+	case gaugeFamily:
+		for _, sample := range ts.Samples {
+			setNumberDataPoint(metric.Gauge().DataPoints().AppendEmpty(), sl, sample)
+		}
+	case counterFamily:
+		for _, sample := range ts.Samples {
+			setNumberDataPoint(metric.Sum().DataPoints().AppendEmpty(), sl, sample)
+		}
+	case histogramFamily:
+		return rb.addHistogramSeries(metric, family, sl, ts.Samples)
+	case summaryFamily:
+		return rb.addSummarySeries(metric, family, sl, ts.Samples)
+	case nativeHistogramFamily:
+		var errs error
+		for _, h := range ts.Histograms {
+			dp := pmetric.NewExponentialHistogramDataPoint()
+			if err := nativeToExponentialHistogram(h, dp); err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("invalid native histogram for metric %q: %w", family, err))
+				continue
+			}
+			sl.copyAttributesTo(dp.Attributes())
+			dp.MoveTo(metric.ExponentialHistogram().DataPoints().AppendEmpty())
+		}
+		return errs
+	}
+	return nil
+}
+
+func (b *metricsBuilder) build() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for _, rb := range b.resources {
+		rb.finish()
+		// drop the metrics whose series had no valid samples
+		rb.scope.Metrics().RemoveIf(func(m pmetric.Metric) bool {
+			return dataPointCount(m) == 0
+		})
+		if rb.scope.Metrics().Len() == 0 {
+			// the resource only had a target_info series
+			continue
+		}
+		rm := md.ResourceMetrics().AppendEmpty()
+		rb.resource.MoveTo(rm.Resource())
+		rb.scope.MoveTo(rm.ScopeMetrics().AppendEmpty())
+	}
+	return md
+}
+
+// resourceBuilder collects the metrics of the series sharing a job and an instance.
+type resourceBuilder struct {
+	resource pcommon.Resource
+	scope    pmetric.ScopeMetrics
+	metrics  map[string]pmetric.Metric
+
+	// the classic histograms and summaries are made of several series, their points
+	// are collected before being added to the metrics
+	histograms []*histogramPoint
+	summaries  []*summaryPoint
+	pointByKey map[string]any
+}
+
+func newResourceBuilder(job, instance string) *resourceBuilder {
+	rb := &resourceBuilder{
+		resource:   pcommon.NewResource(),
+		scope:      pmetric.NewScopeMetrics(),
+		metrics:    make(map[string]pmetric.Metric),
+		pointByKey: make(map[string]any),
+	}
+	attrs := rb.resource.Attributes()
+	if job != "" {
+		// job is made of service.namespace and service.name, see createAttributes
+		if namespace, name, found := strings.Cut(job, "/"); found {
+			attrs.PutStr(conventions.AttributeServiceNamespace, namespace)
+			attrs.PutStr(conventions.AttributeServiceName, name)
+		} else {
+			attrs.PutStr(conventions.AttributeServiceName, job)
+		}
+	}
+	if instance != "" {
+		attrs.PutStr(conventions.AttributeServiceInstanceID, instance)
+	}
+	return rb
+}
+
+func (rb *resourceBuilder) metric(family string, kind familyKind, md prompb.MetricMetadata) pmetric.Metric {
+	key := family + "\x00" + strconv.Itoa(int(kind))
+	if metric, ok := rb.metrics[key]; ok {
+		return metric
+	}
+	metric := rb.scope.Metrics().AppendEmpty()
+	metric.SetName(family)
+	metric.SetDescription(md.Help)
+	metric.SetUnit(md.Unit)
+	switch kind {
+	case gaugeFamily:
+		metric.SetEmptyGauge()
+	case counterFamily:
+		sum := metric.SetEmptySum()
+		sum.SetIsMonotonic(true)
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	case histogramFamily:
+		metric.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	case summaryFamily:
+		metric.SetEmptySummary()
+	case nativeHistogramFamily:
+		metric.SetEmptyExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	}
+	rb.metrics[key] = metric
+	return metric
+}
+
+// histogramPoint collects the samples of the series of a classic histogram at a given time.
+type histogramPoint struct {
+	metric    pmetric.Metric
+	labels    seriesLabels
+	timestamp int64
+	buckets   map[float64]float64
+	sum       float64
+	hasSum    bool
+	count     float64
+	hasCount  bool
+	stale     bool
+}
+
+// summaryPoint collects the samples of the series of a summary at a given time.
+type summaryPoint struct {
+	metric    pmetric.Metric
+	labels    seriesLabels
+	timestamp int64
+	quantiles map[float64]float64
+	sum       float64
+	count     float64
+	stale     bool
+}
+
+func pointKey(family string, sl seriesLabels, timestamp int64) string {
+	return family + "\x00" + sl.signature() + strconv.FormatInt(timestamp, 10)
+}
+
+func (rb *resourceBuilder) addHistogramSeries(metric pmetric.Metric, family string, sl seriesLabels, samples []prompb.Sample) error {
+	var bound float64
+	if strings.HasSuffix(sl.name, bucketStr) {
+		var err error
+		if bound, err = strconv.ParseFloat(sl.le, 64); err != nil {
+			return fmt.Errorf("invalid bucket bound %q for metric %q: %w", sl.le, family, err)
+		}
+	}
+	for _, sample := range samples {
+		key := pointKey(family, sl, sample.Timestamp)
+		pt, ok := rb.pointByKey[key].(*histogramPoint)
+		if !ok {
+			pt = &histogramPoint{metric: metric, labels: sl, timestamp: sample.Timestamp, buckets: make(map[float64]float64)}
+			rb.pointByKey[key] = pt
+			rb.histograms = append(rb.histograms, pt)
+		}
+		if value.IsStaleNaN(sample.Value) {
+			pt.stale = true
+			continue
+		}
+		switch {
+		case strings.HasSuffix(sl.name, bucketStr):
+			pt.buckets[bound] = sample.Value
+		case strings.HasSuffix(sl.name, sumStr):
+			pt.sum, pt.hasSum = sample.Value, true
+		default:
+			pt.count, pt.hasCount = sample.Value, true
+		}
+	}
+	return nil
+}
+
+func (rb *resourceBuilder) addSummarySeries(metric pmetric.Metric, family string, sl seriesLabels, samples []prompb.Sample) error {
+	var quantile float64
+	if sl.quantile != "" {
+		var err error
+		if quantile, err = strconv.ParseFloat(sl.quantile, 64); err != nil {
+			return fmt.Errorf("invalid quantile %q for metric %q: %w", sl.quantile, family, err)
+		}
+	}
+	for _, sample := range samples {
+		key := pointKey(family, sl, sample.Timestamp)
+		pt, ok := rb.pointByKey[key].(*summaryPoint)
+		if !ok {
+			pt = &summaryPoint{metric: metric, labels: sl, timestamp: sample.Timestamp, quantiles: make(map[float64]float64)}
+			rb.pointByKey[key] = pt
+			rb.summaries = append(rb.summaries, pt)
+		}
+		if value.IsStaleNaN(sample.Value) {
+			pt.stale = true
+			continue
+		}
+		switch {
+		case sl.quantile != "":
+			pt.quantiles[quantile] = sample.Value
+		case strings.HasSuffix(sl.name, sumStr):
+			pt.sum = sample.Value
+		default:
+			pt.count = sample.Value
+		}
+	}
+	return nil
+}
+
+// finish adds the collected histogram and summary points to their metrics.
+func (rb *resourceBuilder) finish() {
+	for _, pt := range rb.histograms {
+		dp := pt.metric.Histogram().DataPoints().AppendEmpty()
+		pt.labels.copyAttributesTo(dp.Attributes())
+		dp.SetTimestamp(fromMillis(pt.timestamp))
+		if pt.stale {
+			dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+			continue
+		}
+
+		bounds := make([]float64, 0, len(pt.buckets))
+		for bound := range pt.buckets {
+			if !math.IsInf(bound, 1) {
+				bounds = append(bounds, bound)
+			}
+		}
+		sort.Float64s(bounds)
+		count := pt.count
+		if !pt.hasCount {
+			count = pt.buckets[math.Inf(1)]
+		}
+		// the buckets of prometheus are cumulative, while the otlp ones aren't
+		counts := make([]uint64, 0, len(bounds)+1)
+		var previous float64
+		for _, bound := range bounds {
+			counts = append(counts, uint64(math.Max(pt.buckets[bound]-previous, 0)))
+			previous = math.Max(pt.buckets[bound], previous)
+		}
+		counts = append(counts, uint64(math.Max(count-previous, 0)))
+
+		dp.ExplicitBounds().FromRaw(bounds)
+		dp.BucketCounts().FromRaw(counts)
+		dp.SetCount(uint64(count))
+		if pt.hasSum {
+			dp.SetSum(pt.sum)
+		}
+	}
+
+	for _, pt := range rb.summaries {
+		dp := pt.metric.Summary().DataPoints().AppendEmpty()
+		pt.labels.copyAttributesTo(dp.Attributes())
+		dp.SetTimestamp(fromMillis(pt.timestamp))
+		if pt.stale {
+			dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+			continue
+		}
+		quantiles := make([]float64, 0, len(pt.quantiles))
+		for q := range pt.quantiles {
+			quantiles = append(quantiles, q)
+		}
+		sort.Float64s(quantiles)
+		for _, q := range quantiles {
+			qv := dp.QuantileValues().AppendEmpty()
+			qv.SetQuantile(q)
+			qv.SetValue(pt.quantiles[q])
+		}
+		dp.SetSum(pt.sum)
+		dp.SetCount(uint64(pt.count))
+	}
+}
+
+func dataPointCount(m pmetric.Metric) int {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		return m.Gauge().DataPoints().Len()
+	case pmetric.MetricTypeSum:
+		return m.Sum().DataPoints().Len()
+	case pmetric.MetricTypeHistogram:
+		return m.Histogram().DataPoints().Len()
+	case pmetric.MetricTypeExponentialHistogram:
+		return m.ExponentialHistogram().DataPoints().Len()
+	case pmetric.MetricTypeSummary:
+		return m.Summary().DataPoints().Len()
+	}
+	return 0
+}
+
+func setNumberDataPoint(dp pmetric.NumberDataPoint, sl seriesLabels, sample prompb.Sample) {
+	sl.copyAttributesTo(dp.Attributes())
+	dp.SetTimestamp(fromMillis(sample.Timestamp))
+	if value.IsStaleNaN(sample.Value) {
+		dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+		return
+	}
+	dp.SetDoubleValue(sample.Value)
+}
+
+// nativeToExponentialHistogram translates a Prometheus Native Histogram sample
+// to an OTel Exponential Histogram data point, it's the reverse of exponentialToNativeHistogram.
+func nativeToExponentialHistogram(h prompb.Histogram, dp pmetric.ExponentialHistogramDataPoint) error {
+	if h.Schema < -4 || h.Schema > 8 {
+		return fmt.Errorf("schema must be <= 8 and >= -4, was %d", h.Schema)
+	}
+	dp.SetScale(h.Schema)
+	dp.SetTimestamp(fromMillis(h.Timestamp))
+	if value.IsStaleNaN(h.Sum) {
+		dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+		return nil
+	}
+
+	switch count := h.Count.(type) {
+	case *prompb.Histogram_CountInt:
+		dp.SetCount(count.CountInt)
+	case *prompb.Histogram_CountFloat:
+		dp.SetCount(uint64(count.CountFloat))
+	}
+	switch zeroCount := h.ZeroCount.(type) {
+	case *prompb.Histogram_ZeroCountInt:
+		dp.SetZeroCount(zeroCount.ZeroCountInt)
+	case *prompb.Histogram_ZeroCountFloat:
+		dp.SetZeroCount(uint64(zeroCount.ZeroCountFloat))
+	}
+	dp.SetSum(h.Sum)
+
+	if err := convertSpansLayout(h.PositiveSpans, h.PositiveDeltas, h.PositiveCounts, dp.Positive()); err != nil {
+		return err
+	}
+	return convertSpansLayout(h.NegativeSpans, h.NegativeDeltas, h.NegativeCounts, dp.Negative())
+}
+
+// convertSpansLayout translates Prometheus Native Histogram sparse buckets, given either as deltas
+// or as absolute counts, to the OTel Exponential Histogram dense representation. It's the reverse
+// of convertBucketsLayout: Prometheus bucket index 0 corresponds to OTel bucket index -1.
+func convertSpansLayout(spans []prompb.BucketSpan, deltas []int64, counts []float64, buckets pmetric.ExponentialHistogramDataPointBuckets) error {
+	var (
+		dense    []uint64
+		offset   int64
+		idx      int64
+		pos      int
+		count    int64
+		hasFirst bool
+	)
+	for i, span := range spans {
+		if i == 0 {
+			idx = int64(span.Offset)
+		} else {
+			idx += int64(span.Offset)
+		}
+		for j := uint32(0); j < span.Length; j++ {
+			var c uint64
+			switch {
+			case pos < len(deltas):
+				count += deltas[pos]
+				c = uint64(count)
+			case pos < len(counts):
+				c = uint64(counts[pos])
+			default:
+				return errors.New("the spans describe more buckets than given")
+			}
+			pos++
+
+			if !hasFirst {
+				offset = idx - 1
+				if offset < math.MinInt32 || offset > math.MaxInt32 {
+					return fmt.Errorf("bucket index %d is out of range", idx)
+				}
+				hasFirst = true
+			}
+			if idx-1-offset < int64(len(dense)) {
+				return errors.New("the spans overlap or are not in increasing order")
+			}
+			if idx-1-offset >= maxNativeHistogramBuckets {
+				return fmt.Errorf("the spans cover more than %d buckets", maxNativeHistogramBuckets)
+			}
+			for int64(len(dense)) < idx-1-offset {
+				dense = append(dense, 0)
+			}
+			dense = append(dense, c)
+			idx++
+		}
+	}
+	buckets.SetOffset(int32(offset))
+	buckets.BucketCounts().FromRaw(dense)
+	return nil
+}
+
+// fromMillis converts a prometheus timestamp in ms to an OTLP timestamp
+func fromMillis(ms int64) pcommon.Timestamp {
+	return pcommon.Timestamp(ms * 1e6)
+}
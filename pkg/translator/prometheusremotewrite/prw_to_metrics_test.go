@@ -0,0 +1,266 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewrite
+
+import (
+	"math"
+	"testing"
+
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestToMetricsInferredKinds(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			*getTimeSeries(getPromLabels("__name__", "temperature", "job", "ns/svc", "instance", "host:9090", "room", "a"),
+				getSample(21.5, 1000), getSample(22, 2000)),
+			*getTimeSeries(getPromLabels("__name__", "requests_total", "job", "ns/svc", "instance", "host:9090"),
+				getSample(10, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "latency_bucket", "job", "ns/svc", "instance", "host:9090", "le", "0.1"),
+				getSample(2, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "latency_bucket", "job", "ns/svc", "instance", "host:9090", "le", "1"),
+				getSample(5, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "latency_bucket", "job", "ns/svc", "instance", "host:9090", "le", "+Inf"),
+				getSample(6, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "latency_sum", "job", "ns/svc", "instance", "host:9090"),
+				getSample(3.5, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "latency_count", "job", "ns/svc", "instance", "host:9090"),
+				getSample(6, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "gc_duration", "job", "ns/svc", "instance", "host:9090", "quantile", "0.5"),
+				getSample(0.01, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "gc_duration", "job", "ns/svc", "instance", "host:9090", "quantile", "0.99"),
+				getSample(0.1, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "gc_duration_sum", "job", "ns/svc", "instance", "host:9090"),
+				getSample(0.5, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "gc_duration_count", "job", "ns/svc", "instance", "host:9090"),
+				getSample(20, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "target_info", "job", "ns/svc", "instance", "host:9090", "host_name", "host"),
+				getSample(1, 1000)),
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	rm := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]any{
+		"service.namespace":   "ns",
+		"service.name":        "svc",
+		"service.instance.id": "host:9090",
+		"host_name":           "host",
+	}, rm.Resource().Attributes().AsRaw())
+
+	metrics := rm.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 4, metrics.Len())
+
+	gauge := metrics.At(0)
+	assert.Equal(t, "temperature", gauge.Name())
+	require.Equal(t, pmetric.MetricTypeGauge, gauge.Type())
+	require.Equal(t, 2, gauge.Gauge().DataPoints().Len())
+	assert.Equal(t, 21.5, gauge.Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, pcommon.Timestamp(1e9), gauge.Gauge().DataPoints().At(0).Timestamp())
+	assert.Equal(t, map[string]any{"room": "a"}, gauge.Gauge().DataPoints().At(0).Attributes().AsRaw())
+
+	sum := metrics.At(1)
+	assert.Equal(t, "requests_total", sum.Name())
+	require.Equal(t, pmetric.MetricTypeSum, sum.Type())
+	assert.True(t, sum.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, sum.Sum().AggregationTemporality())
+	assert.Equal(t, 10.0, sum.Sum().DataPoints().At(0).DoubleValue())
+
+	histogram := metrics.At(2)
+	assert.Equal(t, "latency", histogram.Name())
+	require.Equal(t, pmetric.MetricTypeHistogram, histogram.Type())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, histogram.Histogram().AggregationTemporality())
+	require.Equal(t, 1, histogram.Histogram().DataPoints().Len())
+	hdp := histogram.Histogram().DataPoints().At(0)
+	assert.Equal(t, []float64{0.1, 1}, hdp.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{2, 3, 1}, hdp.BucketCounts().AsRaw())
+	assert.Equal(t, uint64(6), hdp.Count())
+	assert.Equal(t, 3.5, hdp.Sum())
+	assert.Equal(t, 0, hdp.Attributes().Len())
+
+	summary := metrics.At(3)
+	assert.Equal(t, "gc_duration", summary.Name())
+	require.Equal(t, pmetric.MetricTypeSummary, summary.Type())
+	require.Equal(t, 1, summary.Summary().DataPoints().Len())
+	sdp := summary.Summary().DataPoints().At(0)
+	assert.Equal(t, uint64(20), sdp.Count())
+	assert.Equal(t, 0.5, sdp.Sum())
+	require.Equal(t, 2, sdp.QuantileValues().Len())
+	assert.Equal(t, 0.5, sdp.QuantileValues().At(0).Quantile())
+	assert.Equal(t, 0.01, sdp.QuantileValues().At(0).Value())
+	assert.Equal(t, 0.99, sdp.QuantileValues().At(1).Quantile())
+	assert.Equal(t, 0.1, sdp.QuantileValues().At(1).Value())
+}
+
+func TestToMetricsMetadata(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			*getTimeSeries(getPromLabels("__name__", "queue_length_total", "job", "svc"), getSample(3, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "processed", "job", "svc"), getSample(5, 1000)),
+		},
+		Metadata: []prompb.MetricMetadata{
+			{MetricFamilyName: "queue_length_total", Type: prompb.MetricMetadata_GAUGE, Help: "length of the queue"},
+			{MetricFamilyName: "processed", Type: prompb.MetricMetadata_COUNTER, Help: "processed items", Unit: "items"},
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	assert.Equal(t, map[string]any{"service.name": "svc"}, md.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	assert.Equal(t, pmetric.MetricTypeGauge, metrics.At(0).Type())
+	assert.Equal(t, "length of the queue", metrics.At(0).Description())
+	assert.Equal(t, pmetric.MetricTypeSum, metrics.At(1).Type())
+	assert.Equal(t, "processed items", metrics.At(1).Description())
+	assert.Equal(t, "items", metrics.At(1).Unit())
+}
+
+func TestToMetricsResources(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			*getTimeSeries(getPromLabels("__name__", "up", "job", "a", "instance", "1"), getSample(1, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "up", "job", "b", "instance", "1"), getSample(1, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "up", "job", "a", "instance", "1"), getSample(0, 2000)),
+			// a target_info without any other series doesn't make a resource
+			*getTimeSeries(getPromLabels("__name__", "target_info", "job", "c", "instance", "1", "k", "v"), getSample(1, 1000)),
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	assert.Equal(t, "a", md.ResourceMetrics().At(0).Resource().Attributes().AsRaw()["service.name"])
+	assert.Equal(t, "b", md.ResourceMetrics().At(1).Resource().Attributes().AsRaw()["service.name"])
+	assert.Equal(t, 2, md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().Len())
+}
+
+func TestToMetricsStaleMarkers(t *testing.T) {
+	stale := math.Float64frombits(value.StaleNaN)
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			*getTimeSeries(getPromLabels("__name__", "up"), getSample(stale, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "latency_bucket", "le", "+Inf"), getSample(stale, 1000)),
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	assert.True(t, metrics.At(0).Gauge().DataPoints().At(0).Flags().NoRecordedValue())
+	assert.True(t, metrics.At(1).Histogram().DataPoints().At(0).Flags().NoRecordedValue())
+}
+
+func TestToMetricsErrors(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			*getTimeSeries(getPromLabels("job", "svc"), getSample(1, 1000)),
+			*getTimeSeries(getPromLabels("__name__", "latency_bucket", "le", "abc"), getSample(1, 1000)),
+			{
+				Labels:     getPromLabels("__name__", "native"),
+				Histograms: []prompb.Histogram{{Schema: 10}},
+			},
+			*getTimeSeries(getPromLabels("__name__", "up"), getSample(1, 1000)),
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "time series without a metric name")
+	assert.ErrorContains(t, err, `invalid bucket bound "abc" for metric "latency"`)
+	assert.ErrorContains(t, err, `invalid native histogram for metric "native": schema must be <= 8 and >= -4, was 10`)
+
+	// the valid series are still converted
+	assert.Equal(t, 1, md.MetricCount())
+	assert.Equal(t, "up", md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+}
+
+func TestToMetricsNativeHistogram(t *testing.T) {
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels: getPromLabels("__name__", "latency", "method", "GET"),
+				Histograms: []prompb.Histogram{
+					{
+						Count:          &prompb.Histogram_CountInt{CountInt: 13},
+						Sum:            42,
+						Schema:         1,
+						ZeroThreshold:  defaultZeroThreshold,
+						ZeroCount:      &prompb.Histogram_ZeroCountInt{ZeroCountInt: 1},
+						PositiveSpans:  []prompb.BucketSpan{{Offset: 2, Length: 2}, {Offset: 1, Length: 1}},
+						PositiveDeltas: []int64{4, -2, 2},
+						NegativeSpans:  []prompb.BucketSpan{{Offset: 0, Length: 1}},
+						NegativeCounts: []float64{2},
+						Timestamp:      1000,
+					},
+				},
+			},
+		},
+	}
+
+	md, err := ToMetrics(req)
+	require.NoError(t, err)
+	metric := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "latency", metric.Name())
+	require.Equal(t, pmetric.MetricTypeExponentialHistogram, metric.Type())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, metric.ExponentialHistogram().AggregationTemporality())
+	dp := metric.ExponentialHistogram().DataPoints().At(0)
+	assert.Equal(t, map[string]any{"method": "GET"}, dp.Attributes().AsRaw())
+	assert.Equal(t, int32(1), dp.Scale())
+	assert.Equal(t, uint64(13), dp.Count())
+	assert.Equal(t, 42.0, dp.Sum())
+	assert.Equal(t, uint64(1), dp.ZeroCount())
+	assert.Equal(t, pcommon.Timestamp(1e9), dp.Timestamp())
+	assert.Equal(t, int32(1), dp.Positive().Offset())
+	assert.Equal(t, []uint64{4, 2, 0, 4}, dp.Positive().BucketCounts().AsRaw())
+	assert.Equal(t, int32(-1), dp.Negative().Offset())
+	assert.Equal(t, []uint64{2}, dp.Negative().BucketCounts().AsRaw())
+}
+
+func TestNativeToExponentialHistogramRoundTrip(t *testing.T) {
+	expected := pmetric.NewExponentialHistogramDataPoint()
+	expected.SetScale(3)
+	expected.SetCount(20)
+	expected.SetSum(1234.5)
+	expected.SetZeroCount(2)
+	expected.SetTimestamp(pcommon.Timestamp(5000 * 1e6))
+	expected.Positive().SetOffset(-3)
+	expected.Positive().BucketCounts().FromRaw([]uint64{1, 0, 0, 0, 0, 3, 2, 1})
+	expected.Negative().SetOffset(4)
+	expected.Negative().BucketCounts().FromRaw([]uint64{5, 1, 5})
+
+	h, err := exponentialToNativeHistogram(expected)
+	require.NoError(t, err)
+	actual := pmetric.NewExponentialHistogramDataPoint()
+	require.NoError(t, nativeToExponentialHistogram(h, actual))
+	assert.Equal(t, expected, actual)
+}
+
+func TestConvertSpansLayoutErrors(t *testing.T) {
+	buckets := pmetric.NewExponentialHistogramDataPoint().Positive()
+	err := convertSpansLayout([]prompb.BucketSpan{{Offset: 0, Length: 3}}, []int64{1, 1}, nil, buckets)
+	assert.EqualError(t, err, "the spans describe more buckets than given")
+
+	err = convertSpansLayout([]prompb.BucketSpan{{Offset: 0, Length: 1}, {Offset: math.MaxInt32, Length: 1}}, []int64{1, 1}, nil, buckets)
+	assert.EqualError(t, err, "the spans cover more than 1048576 buckets")
+
+	err = convertSpansLayout([]prompb.BucketSpan{{Offset: 0, Length: 1}, {Offset: math.MaxInt32, Length: 1}, {Offset: math.MaxInt32, Length: 1}}, []int64{1, 1, 1}, nil, buckets)
+	assert.EqualError(t, err, "the spans cover more than 1048576 buckets")
+
+	err = convertSpansLayout([]prompb.BucketSpan{{Offset: 0, Length: 2}, {Offset: -2, Length: 1}}, []int64{1, 1, 1}, nil, buckets)
+	assert.EqualError(t, err, "the spans overlap or are not in increasing order")
+
+	err = convertSpansLayout([]prompb.BucketSpan{{Offset: math.MinInt32, Length: 1}}, []int64{1}, nil, buckets)
+	assert.EqualError(t, err, "bucket index -2147483648 is out of range")
+}
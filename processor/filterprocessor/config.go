@@ -77,6 +77,11 @@ type TraceFilters struct {
 	// If any condition resolves to true, the span event will be dropped.
 	// Supports `and`, `or`, and `()`
 	SpanEventConditions []string `mapstructure:"spanevent"`
+
+	// SpanLinkConditions is a list of OTTL conditions for an ottlspanlink context.
+	// If any condition resolves to true, the span link will be dropped.
+	// Supports `and`, `or`, and `()`
+	SpanLinkConditions []string `mapstructure:"spanlink"`
 }
 
 // LogFilters filters by Log properties.
@@ -268,7 +273,7 @@ var _ component.Config = (*Config)(nil)
 
 // Validate checks if the processor configuration is valid
 func (cfg *Config) Validate() error {
-	if (cfg.Traces.SpanConditions != nil || cfg.Traces.SpanEventConditions != nil) && (cfg.Spans.Include != nil || cfg.Spans.Exclude != nil) {
+	if (cfg.Traces.SpanConditions != nil || cfg.Traces.SpanEventConditions != nil || cfg.Traces.SpanLinkConditions != nil) && (cfg.Spans.Include != nil || cfg.Spans.Exclude != nil) {
 		return fmt.Errorf("cannot use ottl conditions and include/exclude for spans at the same time")
 	}
 	if (cfg.Metrics.MetricConditions != nil || cfg.Metrics.DataPointConditions != nil) && (cfg.Metrics.Include != nil || cfg.Metrics.Exclude != nil) {
@@ -290,6 +295,11 @@ func (cfg *Config) Validate() error {
 		errors = multierr.Append(errors, err)
 	}
 
+	if cfg.Traces.SpanLinkConditions != nil {
+		_, err := filterottl.NewBoolExprForSpanLink(cfg.Traces.SpanLinkConditions, filterottl.StandardSpanLinkFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()})
+		errors = multierr.Append(errors, err)
+	}
+
 	if cfg.Metrics.MetricConditions != nil {
 		_, err := filterottl.NewBoolExprForMetric(cfg.Metrics.MetricConditions, common.MetricFunctions(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()})
 		errors = multierr.Append(errors, err)
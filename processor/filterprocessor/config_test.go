@@ -853,6 +853,9 @@ func TestLoadingConfigOTTL(t *testing.T) {
 					SpanEventConditions: []string{
 						`attributes["test"] == "pass"`,
 					},
+					SpanLinkConditions: []string{
+						`attributes["test"] == "pass"`,
+					},
 				},
 				Metrics: MetricFilters{
 					MetricConditions: []string{
@@ -901,6 +904,10 @@ func TestLoadingConfigOTTL(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "bad_syntax_spanevent"),
 			errorMessage: "unable to parse OTTL statement: 1:25: unexpected token \"test\" (expected (<string> | <int>) \"]\")",
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "bad_syntax_spanlink"),
+			errorMessage: "unable to parse OTTL statement: 1:25: unexpected token \"test\" (expected (<string> | <int>) \"]\")",
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "bad_syntax_metric"),
 			errorMessage: "unable to parse OTTL statement: 1:34: unexpected token \"test\" (expected (<string> | <int>) \"]\")",
@@ -223,6 +223,16 @@ func TestFilterTraceProcessorWithOTTL(t *testing.T) {
 			filterEverything: true,
 			errorMode:        ottl.IgnoreError,
 		},
+		{
+			name: "drop spans by duration",
+			conditions: TraceFilters{
+				SpanConditions: []string{
+					`end_time - start_time > Duration("1s")`,
+				},
+			},
+			filterEverything: true,
+			errorMode:        ottl.IgnoreError,
+		},
 		{
 			name: "drop span events",
 			conditions: TraceFilters{
@@ -240,6 +250,37 @@ func TestFilterTraceProcessorWithOTTL(t *testing.T) {
 			},
 			errorMode: ottl.IgnoreError,
 		},
+		{
+			name: "drop span links",
+			conditions: TraceFilters{
+				SpanLinkConditions: []string{
+					`attributes["test"] == "pass"`,
+				},
+			},
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Links().RemoveIf(func(link ptrace.SpanLink) bool {
+					return link.Attributes().Len() > 0
+				})
+				td.ResourceSpans().At(0).ScopeSpans().At(1).Spans().At(1).Links().RemoveIf(func(link ptrace.SpanLink) bool {
+					return link.Attributes().Len() > 0
+				})
+			},
+			errorMode: ottl.IgnoreError,
+		},
+		{
+			name: "drop span links by parent span",
+			conditions: TraceFilters{
+				SpanLinkConditions: []string{
+					`span.name == "operationB" and instrumentation_scope.name == "scope2"`,
+				},
+			},
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(1).Spans().At(1).Links().RemoveIf(func(link ptrace.SpanLink) bool {
+					return true
+				})
+			},
+			errorMode: ottl.IgnoreError,
+		},
 		{
 			name: "multiple conditions",
 			conditions: TraceFilters{
@@ -327,6 +368,7 @@ func fillSpanTwo(span ptrace.Span) {
 	span.Attributes().PutStr("flags", "C|D")
 	link0 := span.Links().AppendEmpty()
 	link0.SetDroppedAttributesCount(4)
+	link0.Attributes().PutStr("test", "pass")
 	link1 := span.Links().AppendEmpty()
 	link1.SetDroppedAttributesCount(4)
 	span.SetDroppedLinksCount(3)
@@ -4,9 +4,14 @@
 package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor"
 
 import (
+	"errors"
 	"time"
+
+	"go.opentelemetry.io/collector/component"
 )
 
+var errStorageIDRequired = errors.New("option 'store_on_disk' requires a 'storage' extension to be configured")
+
 // Config is the configuration for the processor.
 type Config struct {
 
@@ -22,15 +27,28 @@ type Config struct {
 	// Default: 1s.
 	WaitDuration time.Duration `mapstructure:"wait_duration"`
 
-	// DiscardOrphans instructs the processor to discard traces without the root span.
-	// This typically indicates that the trace is incomplete.
+	// DiscardOrphans instructs the processor to discard the spans arriving after their trace has been released.
+	// When false, such spans are forwarded right away as a partial trace instead.
 	// Default: false.
-	// Not yet implemented, and an error will be returned when this option is used.
 	DiscardOrphans bool `mapstructure:"discard_orphans"`
 
 	// StoreOnDisk tells the processor to keep only the trace ID in memory, serializing the trace spans to disk.
 	// Useful when the duration to wait for traces to complete is high.
+	// Requires StorageID to be set.
 	// Default: false.
-	// Not yet implemented, and an error will be returned when this option is used.
 	StoreOnDisk bool `mapstructure:"store_on_disk"`
+
+	// StorageID is the ID of the storage extension the spans are written to when StoreOnDisk is enabled,
+	// such as the file_storage extension.
+	StorageID *component.ID `mapstructure:"storage"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.StoreOnDisk && cfg.StorageID == nil {
+		return errStorageIDRequired
+	}
+	return nil
 }
@@ -95,9 +95,10 @@ func newEventMachine(logger *zap.Logger, bufferSize int, numWorkers int, numTrac
 	}
 	for i := range em.workers {
 		em.workers[i] = &eventMachineWorker{
-			machine: em,
-			buffer:  newRingBuffer(numTraces / numWorkers),
-			events:  make(chan event, bufferSize/numWorkers),
+			machine:  em,
+			buffer:   newRingBuffer(numTraces / numWorkers),
+			released: newRingBuffer(numTraces / numWorkers),
+			events:   make(chan event, bufferSize/numWorkers),
 		}
 	}
 	return em
@@ -307,6 +308,10 @@ type eventMachineWorker struct {
 	// the ring buffer holds the IDs for all the in-flight traces
 	buffer *ringBuffer
 
+	// the ring buffer holds the IDs of the most recently released traces, so that
+	// spans arriving late for them can be identified as orphans
+	released *ringBuffer
+
 	events chan event
 }
 
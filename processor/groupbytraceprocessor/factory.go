@@ -5,7 +5,6 @@ package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"go.opencensus.io/stats/view"
@@ -24,11 +23,6 @@ const (
 	defaultStoreOnDisk    = false
 )
 
-var (
-	errDiskStorageNotSupported    = fmt.Errorf("option 'disk storage' not supported in this release")
-	errDiscardOrphansNotSupported = fmt.Errorf("option 'discard orphans' not supported in this release")
-)
-
 // NewFactory returns a new factory for the Filter processor.
 func NewFactory() processor.Factory {
 	// TODO: find a more appropriate way to get this done, as we are swallowing the error here
@@ -43,11 +37,9 @@ func NewFactory() processor.Factory {
 // createDefaultConfig creates the default configuration for the processor.
 func createDefaultConfig() component.Config {
 	return &Config{
-		NumTraces:    defaultNumTraces,
-		NumWorkers:   defaultNumWorkers,
-		WaitDuration: defaultWaitDuration,
-
-		// not supported for now
+		NumTraces:      defaultNumTraces,
+		NumWorkers:     defaultNumWorkers,
+		WaitDuration:   defaultWaitDuration,
 		DiscardOrphans: defaultDiscardOrphans,
 		StoreOnDisk:    defaultStoreOnDisk,
 	}
@@ -64,14 +56,13 @@ func createTracesProcessor(
 
 	var st storage
 	if oCfg.StoreOnDisk {
-		return nil, errDiskStorageNotSupported
+		if oCfg.StorageID == nil {
+			return nil, errStorageIDRequired
+		}
+		st = newDiskStorage(params.Logger, *oCfg.StorageID, params.ID)
+	} else {
+		st = newMemoryStorage()
 	}
-	if oCfg.DiscardOrphans {
-		return nil, errDiscardOrphansNotSupported
-	}
-
-	// the only supported storage for now
-	st = newMemoryStorage()
 
 	return newGroupByTraceProcessor(params.Logger, st, nextConsumer, *oCfg), nil
 }
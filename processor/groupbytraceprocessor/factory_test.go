@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/processor/processortest"
 )
 
@@ -36,7 +37,7 @@ func TestCreateTestProcessor(t *testing.T) {
 	assert.NotNil(t, p)
 }
 
-func TestCreateTestProcessorWithNotImplementedOptions(t *testing.T) {
+func TestCreateTestProcessorWithInvalidOptions(t *testing.T) {
 	// prepare
 	f := NewFactory()
 	next := &mockProcessor{}
@@ -46,17 +47,11 @@ func TestCreateTestProcessorWithNotImplementedOptions(t *testing.T) {
 		config      *Config
 		expectedErr error
 	}{
-		{
-			&Config{
-				DiscardOrphans: true,
-			},
-			errDiscardOrphansNotSupported,
-		},
 		{
 			&Config{
 				StoreOnDisk: true,
 			},
-			errDiskStorageNotSupported,
+			errStorageIDRequired,
 		},
 	} {
 		p, err := f.CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), tt.config, next)
@@ -66,3 +61,24 @@ func TestCreateTestProcessorWithNotImplementedOptions(t *testing.T) {
 		assert.Nil(t, p)
 	}
 }
+
+func TestCreateTestProcessorWithDiskStorage(t *testing.T) {
+	c := createDefaultConfig().(*Config)
+	storageID := component.NewID("file_storage")
+	c.StoreOnDisk = true
+	c.StorageID = &storageID
+	assert.NoError(t, c.Validate())
+
+	// test
+	p, err := createTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), c, &mockProcessor{})
+
+	// verify
+	assert.NoError(t, err)
+	assert.NotNil(t, p)
+}
+
+func TestValidateConfigRequiresStorage(t *testing.T) {
+	c := createDefaultConfig().(*Config)
+	c.StoreOnDisk = true
+	assert.ErrorIs(t, c.Validate(), errStorageIDRequired)
+}
@@ -3,6 +3,7 @@ module github.com/open-telemetry/opentelemetry-collector-contrib/processor/group
 go 1.19
 
 require (
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage v0.77.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal v0.77.0
 	github.com/stretchr/testify v1.8.2
 	go.opencensus.io v0.24.0
@@ -45,6 +46,8 @@ require (
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/pkg/batchpersignal => ../../pkg/batchpersignal
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage => ../../extension/storage
+
 retract (
 	v0.76.2
 	v0.76.1
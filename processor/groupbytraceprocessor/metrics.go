@@ -20,6 +20,8 @@ var (
 	mReleasedSpans      = stats.Int64("processor_groupbytrace_spans_released", "Spans released to the next consumer", stats.UnitDimensionless)
 	mReleasedTraces     = stats.Int64("processor_groupbytrace_traces_released", "Traces released to the next consumer", stats.UnitDimensionless)
 	mIncompleteReleases = stats.Int64("processor_groupbytrace_incomplete_releases", "Releases that are suspected to have been incomplete", stats.UnitDimensionless)
+	mOrphansDiscarded   = stats.Int64("processor_groupbytrace_orphan_spans_discarded", "Spans discarded because their trace had already been released", stats.UnitDimensionless)
+	mOrphansForwarded   = stats.Int64("processor_groupbytrace_orphan_spans_forwarded", "Spans forwarded as a partial trace because their trace had already been released", stats.UnitDimensionless)
 	mEventLatency       = stats.Int64("processor_groupbytrace_event_latency", "How long the queue events are taking to be processed", stats.UnitMilliseconds)
 )
 
@@ -69,6 +71,18 @@ func MetricViews() []*view.View {
 			Description: mIncompleteReleases.Description(),
 			Aggregation: view.Sum(),
 		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mOrphansDiscarded.Name()),
+			Measure:     mOrphansDiscarded,
+			Description: mOrphansDiscarded.Description(),
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mOrphansForwarded.Name()),
+			Measure:     mOrphansForwarded,
+			Description: mOrphansForwarded.Description(),
+			Aggregation: view.Sum(),
+		},
 		{
 			Name:        obsreport.BuildProcessorCustomMetricName(string(metadata.Type), mEventLatency.Name()),
 			Measure:     mEventLatency,
@@ -18,6 +18,8 @@ func TestProcessorMetrics(t *testing.T) {
 		"processor/groupbytrace/processor_groupbytrace_spans_released",
 		"processor/groupbytrace/processor_groupbytrace_traces_released",
 		"processor/groupbytrace/processor_groupbytrace_incomplete_releases",
+		"processor/groupbytrace/processor_groupbytrace_orphan_spans_discarded",
+		"processor/groupbytrace/processor_groupbytrace_orphan_spans_forwarded",
 		"processor/groupbytrace/processor_groupbytrace_event_latency",
 	}
 
@@ -32,6 +32,8 @@ import (
 // async markAsReleased -> event(traceReleased) -> onTraceReleased -> nextConsumer
 // Each worker in the eventMachine also uses a ring buffer to hold the in-flight trace IDs, so that we don't hold more than the given maximum number
 // of traces in memory/storage. Items that are evicted from the buffer are discarded without warning.
+// The IDs of the released traces are kept in a second ring buffer, so that spans arriving after their trace has been
+// released are either discarded or forwarded right away, depending on the DiscardOrphans option.
 type groupByTraceProcessor struct {
 	nextConsumer consumer.Traces
 	config       Config
@@ -83,14 +85,16 @@ func (sp *groupByTraceProcessor) Capabilities() consumer.Capabilities {
 }
 
 // Start is invoked during service startup.
-func (sp *groupByTraceProcessor) Start(context.Context, component.Host) error {
+func (sp *groupByTraceProcessor) Start(ctx context.Context, host component.Host) error {
 	// start these metrics, as it might take a while for them to receive their first event
 	stats.Record(context.Background(), mTracesEvicted.M(0))
 	stats.Record(context.Background(), mIncompleteReleases.M(0))
+	stats.Record(context.Background(), mOrphansDiscarded.M(0))
+	stats.Record(context.Background(), mOrphansForwarded.M(0))
 	stats.Record(context.Background(), mNumTracesConf.M(int64(sp.config.NumTraces)))
 
 	sp.eventMachine.startInBackground()
-	return sp.st.start()
+	return sp.st.start(ctx, host)
 }
 
 // Shutdown is invoked during service shutdown.
@@ -113,6 +117,12 @@ func (sp *groupByTraceProcessor) onTraceReceived(trace tracesWithID, worker *eve
 		return nil
 	}
 
+	if worker.released.contains(traceID) {
+		// the trace has been released already, these spans won't be part of it anymore
+		sp.onOrphanReceived(trace)
+		return nil
+	}
+
 	// at this point, we determined that we haven't seen the trace yet, so, record the
 	// traceID in the map and the spans to the storage
 
@@ -160,8 +170,9 @@ func (sp *groupByTraceProcessor) onTraceExpired(traceID pcommon.TraceID, worker
 		return nil
 	}
 
-	// delete from the map and erase its memory entry
+	// delete from the map and erase its memory entry, remembering that it got released
 	worker.buffer.delete(traceID)
+	worker.released.put(traceID)
 
 	// this might block, but we don't need to wait
 	sp.logger.Debug("marking the trace as released", zap.Stringer("traceID", traceID))
@@ -218,6 +229,28 @@ func (sp *groupByTraceProcessor) onTraceReleased(rss []ptrace.ResourceSpans) err
 	return nil
 }
 
+// onOrphanReceived handles spans received for a trace that has been released already:
+// they are either discarded or forwarded right away as a partial trace, instead of
+// being held as a new trace for the entire duration.
+func (sp *groupByTraceProcessor) onOrphanReceived(trace tracesWithID) {
+	spanCount := int64(trace.td.SpanCount())
+	if sp.config.DiscardOrphans {
+		sp.logger.Debug("discarding spans of a released trace", zap.Stringer("traceID", trace.id))
+		stats.Record(context.Background(), mOrphansDiscarded.M(spanCount))
+		return
+	}
+
+	sp.logger.Debug("forwarding spans of a released trace", zap.Stringer("traceID", trace.id))
+	stats.Record(context.Background(), mOrphansForwarded.M(spanCount))
+
+	// Do async consuming not to block event worker
+	go func() {
+		if err := sp.nextConsumer.ConsumeTraces(context.Background(), trace.td); err != nil {
+			sp.logger.Error("consume failed", zap.Error(err))
+		}
+	}()
+}
+
 func (sp *groupByTraceProcessor) onTraceRemoved(traceID pcommon.TraceID) error {
 	trace, err := sp.st.delete(traceID)
 	if err != nil {
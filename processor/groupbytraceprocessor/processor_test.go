@@ -545,6 +545,105 @@ func TestAsyncOnRelease(t *testing.T) {
 	close(blockCh)
 }
 
+func TestOrphanSpansAreForwarded(t *testing.T) {
+	// prepare
+	config := Config{
+		WaitDuration: time.Nanosecond,
+		NumTraces:    10,
+		NumWorkers:   1,
+	}
+	received := make(chan ptrace.Traces, 2)
+	next := &mockProcessor{
+		onTraces: func(ctx context.Context, td ptrace.Traces) error {
+			received <- td
+			return nil
+		},
+	}
+
+	st := &mockStorage{
+		onCreateOrAppend: func(pcommon.TraceID, ptrace.Traces) error {
+			assert.Fail(t, "the orphan spans should not reach the storage")
+			return nil
+		},
+	}
+
+	p := newGroupByTraceProcessor(zap.NewNop(), st, next, config)
+	worker := p.eventMachine.workers[0]
+
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	worker.released.put(traceID)
+	trace := simpleTracesWithID(traceID)
+
+	// test
+	assert.NoError(t, p.onTraceReceived(tracesWithID{id: traceID, td: trace}, worker))
+
+	// verify
+	select {
+	case td := <-received:
+		assert.Equal(t, trace, td)
+	case <-time.After(time.Second):
+		t.Fatal("the orphan spans were not forwarded")
+	}
+	assert.False(t, worker.buffer.contains(traceID))
+}
+
+func TestOrphanSpansAreDiscarded(t *testing.T) {
+	// prepare
+	config := Config{
+		WaitDuration:   time.Nanosecond,
+		NumTraces:      10,
+		NumWorkers:     1,
+		DiscardOrphans: true,
+	}
+	next := &mockProcessor{
+		onTraces: func(ctx context.Context, td ptrace.Traces) error {
+			assert.Fail(t, "the orphan spans should have been discarded")
+			return nil
+		},
+	}
+	st := &mockStorage{
+		onCreateOrAppend: func(pcommon.TraceID, ptrace.Traces) error {
+			assert.Fail(t, "the orphan spans should not reach the storage")
+			return nil
+		},
+	}
+
+	p := newGroupByTraceProcessor(zap.NewNop(), st, next, config)
+	worker := p.eventMachine.workers[0]
+
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	worker.released.put(traceID)
+
+	// test
+	assert.NoError(t, p.onTraceReceived(tracesWithID{id: traceID, td: simpleTracesWithID(traceID)}, worker))
+
+	// verify
+	assert.False(t, worker.buffer.contains(traceID))
+}
+
+func TestReleasedTraceIsRemembered(t *testing.T) {
+	// prepare
+	config := Config{
+		WaitDuration: time.Nanosecond,
+		NumTraces:    10,
+		NumWorkers:   1,
+	}
+	st := newMemoryStorage()
+	p := newGroupByTraceProcessor(zap.NewNop(), st, &mockProcessor{}, config)
+	worker := p.eventMachine.workers[0]
+
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	worker.buffer.put(traceID)
+	require.NoError(t, st.createOrAppend(traceID, simpleTracesWithID(traceID)))
+
+	// test
+	assert.NoError(t, p.onTraceExpired(traceID, worker))
+
+	// verify
+	assert.False(t, worker.buffer.contains(traceID))
+	assert.True(t, worker.released.contains(traceID))
+}
+
 func BenchmarkConsumeTracesCompleteOnFirstBatch(b *testing.B) {
 	// prepare
 	config := Config{
@@ -606,7 +705,7 @@ type mockStorage struct {
 	onCreateOrAppend func(pcommon.TraceID, ptrace.Traces) error
 	onGet            func(pcommon.TraceID) ([]ptrace.ResourceSpans, error)
 	onDelete         func(pcommon.TraceID) ([]ptrace.ResourceSpans, error)
-	onStart          func() error
+	onStart          func(context.Context, component.Host) error
 	onShutdown       func() error
 }
 
@@ -630,9 +729,9 @@ func (st *mockStorage) delete(traceID pcommon.TraceID) ([]ptrace.ResourceSpans,
 	}
 	return nil, nil
 }
-func (st *mockStorage) start() error {
+func (st *mockStorage) start(ctx context.Context, host component.Host) error {
 	if st.onStart != nil {
-		return st.onStart()
+		return st.onStart(ctx, host)
 	}
 	return nil
 }
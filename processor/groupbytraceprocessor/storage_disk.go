@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opentelemetry.io/collector/component"
+	extstorage "go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+// diskStorage keeps only the trace IDs in memory and writes the spans to a
+// storage extension. Every batch of spans received for a trace is stored
+// under its own key, so that appending to a trace never requires reading it.
+type diskStorage struct {
+	sync.RWMutex
+	// content maps each trace ID to the number of batches stored for it
+	content map[pcommon.TraceID]int
+
+	storageID   component.ID
+	componentID component.ID
+	client      extstorage.Client
+	logger      *zap.Logger
+
+	marshaler   ptrace.Marshaler
+	unmarshaler ptrace.Unmarshaler
+
+	metricsCollectionInterval time.Duration
+	stopCh                    chan struct{}
+	stopWG                    sync.WaitGroup
+}
+
+var _ storage = (*diskStorage)(nil)
+
+func newDiskStorage(logger *zap.Logger, storageID, componentID component.ID) *diskStorage {
+	return &diskStorage{
+		content:                   make(map[pcommon.TraceID]int),
+		storageID:                 storageID,
+		componentID:               componentID,
+		logger:                    logger,
+		marshaler:                 &ptrace.ProtoMarshaler{},
+		unmarshaler:               &ptrace.ProtoUnmarshaler{},
+		metricsCollectionInterval: time.Second,
+		stopCh:                    make(chan struct{}),
+	}
+}
+
+func (st *diskStorage) createOrAppend(traceID pcommon.TraceID, td ptrace.Traces) error {
+	buf, err := st.marshaler.MarshalTraces(td)
+	if err != nil {
+		return err
+	}
+
+	st.Lock()
+	defer st.Unlock()
+
+	batch := st.content[traceID]
+	if err := st.client.Set(context.Background(), batchKey(traceID, batch), buf); err != nil {
+		return err
+	}
+	st.content[traceID] = batch + 1
+	return nil
+}
+
+func (st *diskStorage) get(traceID pcommon.TraceID) ([]ptrace.ResourceSpans, error) {
+	st.RLock()
+	defer st.RUnlock()
+
+	batches, ok := st.content[traceID]
+	if !ok {
+		return nil, nil
+	}
+	return st.read(traceID, batches)
+}
+
+func (st *diskStorage) delete(traceID pcommon.TraceID) ([]ptrace.ResourceSpans, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	batches, ok := st.content[traceID]
+	if !ok {
+		return nil, nil
+	}
+	result, err := st.read(traceID, batches)
+	if err != nil {
+		return nil, err
+	}
+	if err := st.remove(traceID, batches); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// read loads all batches of the given trace from the storage. It must be
+// called with the lock held.
+func (st *diskStorage) read(traceID pcommon.TraceID, batches int) ([]ptrace.ResourceSpans, error) {
+	ops := make([]extstorage.Operation, batches)
+	for i := range ops {
+		ops[i] = extstorage.GetOperation(batchKey(traceID, i))
+	}
+	if err := st.client.Batch(context.Background(), ops...); err != nil {
+		return nil, err
+	}
+
+	var result []ptrace.ResourceSpans
+	for i, op := range ops {
+		if op.Value == nil {
+			return nil, fmt.Errorf("batch %d of trace %q is missing from the storage", i, traceID)
+		}
+		td, err := st.unmarshaler.UnmarshalTraces(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		rss := td.ResourceSpans()
+		for j := 0; j < rss.Len(); j++ {
+			result = append(result, rss.At(j))
+		}
+	}
+	return result, nil
+}
+
+// remove deletes all batches of the given trace from the storage and forgets
+// the trace ID. It must be called with the lock held.
+func (st *diskStorage) remove(traceID pcommon.TraceID, batches int) error {
+	ops := make([]extstorage.Operation, batches)
+	for i := range ops {
+		ops[i] = extstorage.DeleteOperation(batchKey(traceID, i))
+	}
+	if err := st.client.Batch(context.Background(), ops...); err != nil {
+		return err
+	}
+	delete(st.content, traceID)
+	return nil
+}
+
+func (st *diskStorage) start(ctx context.Context, host component.Host) error {
+	ext, ok := host.GetExtensions()[st.storageID]
+	if !ok {
+		return fmt.Errorf("storage extension '%s' not found", st.storageID)
+	}
+	storageExt, ok := ext.(extstorage.Extension)
+	if !ok {
+		return fmt.Errorf("non-storage extension '%s' found", st.storageID)
+	}
+	client, err := storageExt.GetClient(ctx, component.KindProcessor, st.componentID, "")
+	if err != nil {
+		return err
+	}
+	st.client = client
+
+	st.stopWG.Add(1)
+	go st.periodicMetrics()
+	return nil
+}
+
+// shutdown removes the traces that were not released yet from the storage,
+// as their IDs are only known to this instance, and closes the client.
+func (st *diskStorage) shutdown() error {
+	close(st.stopCh)
+	st.stopWG.Wait()
+
+	if st.client == nil {
+		return nil
+	}
+
+	st.Lock()
+	defer st.Unlock()
+	for traceID, batches := range st.content {
+		if err := st.remove(traceID, batches); err != nil {
+			st.logger.Warn("failed to remove trace from the storage", zap.Stringer("traceID", traceID), zap.Error(err))
+		}
+	}
+	return st.client.Close(context.Background())
+}
+
+func (st *diskStorage) periodicMetrics() {
+	defer st.stopWG.Done()
+	ticker := time.NewTicker(st.metricsCollectionInterval)
+	defer ticker.Stop()
+	for {
+		stats.Record(context.Background(), mNumTracesInMemory.M(int64(st.count())))
+		select {
+		case <-ticker.C:
+		case <-st.stopCh:
+			return
+		}
+	}
+}
+
+func (st *diskStorage) count() int {
+	st.RLock()
+	defer st.RUnlock()
+	return len(st.content)
+}
+
+func batchKey(traceID pcommon.TraceID, batch int) string {
+	return fmt.Sprintf("%s/%d", traceID, batch)
+}
@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbytraceprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor/internal/metadata"
+)
+
+func startedDiskStorage(t *testing.T) *diskStorage {
+	host := storagetest.NewStorageHost().WithInMemoryStorageExtension("test")
+	st := newDiskStorage(zap.NewNop(), storagetest.NewStorageID("test"), component.NewID(metadata.Type))
+	require.NoError(t, st.start(context.Background(), host))
+	return st
+}
+
+func singleSpanTrace(traceID pcommon.TraceID, name string) ptrace.Traces {
+	trace := ptrace.NewTraces()
+	span := trace.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetTraceID(traceID)
+	span.SetName(name)
+	return trace
+}
+
+func TestDiskCreateAppendAndGetTrace(t *testing.T) {
+	st := startedDiskStorage(t)
+	defer func() { assert.NoError(t, st.shutdown()) }()
+
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	otherID := pcommon.TraceID([16]byte{2, 3, 4, 5})
+	require.NoError(t, st.createOrAppend(traceID, singleSpanTrace(traceID, "first")))
+	require.NoError(t, st.createOrAppend(otherID, singleSpanTrace(otherID, "other")))
+	require.NoError(t, st.createOrAppend(traceID, singleSpanTrace(traceID, "second")))
+	assert.Equal(t, 2, st.count())
+
+	retrieved, err := st.get(traceID)
+	require.NoError(t, err)
+	require.Len(t, retrieved, 2)
+	assert.Equal(t, "first", retrieved[0].ScopeSpans().At(0).Spans().At(0).Name())
+	assert.Equal(t, "second", retrieved[1].ScopeSpans().At(0).Spans().At(0).Name())
+
+	missing, err := st.get(pcommon.TraceID([16]byte{9}))
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestDiskDeleteTrace(t *testing.T) {
+	st := startedDiskStorage(t)
+	defer func() { assert.NoError(t, st.shutdown()) }()
+
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	require.NoError(t, st.createOrAppend(traceID, singleSpanTrace(traceID, "first")))
+
+	deleted, err := st.delete(traceID)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, "first", deleted[0].ScopeSpans().At(0).Spans().At(0).Name())
+	assert.Equal(t, 0, st.count())
+
+	// the spans are gone from the storage as well
+	value, err := st.client.Get(context.Background(), batchKey(traceID, 0))
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	deleted, err = st.delete(traceID)
+	require.NoError(t, err)
+	assert.Nil(t, deleted)
+}
+
+func TestDiskShutdownRemovesPendingTraces(t *testing.T) {
+	host := storagetest.NewStorageHost().WithFileBackedStorageExtension("test", t.TempDir())
+	newStorage := func() *diskStorage {
+		st := newDiskStorage(zap.NewNop(), storagetest.NewStorageID("test"), component.NewID(metadata.Type))
+		require.NoError(t, st.start(context.Background(), host))
+		return st
+	}
+
+	st := newStorage()
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	require.NoError(t, st.createOrAppend(traceID, singleSpanTrace(traceID, "pending")))
+	require.NoError(t, st.shutdown())
+
+	// a new instance reading the same storage doesn't find leftovers
+	st = newStorage()
+	defer func() { assert.NoError(t, st.shutdown()) }()
+	value, err := st.client.Get(context.Background(), batchKey(traceID, 0))
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestDiskStartWithInvalidExtension(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		storageID component.ID
+		host      component.Host
+	}{
+		{
+			name:      "missing",
+			storageID: storagetest.NewStorageID("test"),
+			host:      componenttest.NewNopHost(),
+		},
+		{
+			name:      "not a storage",
+			storageID: storagetest.NewNonStorageID("test"),
+			host:      storagetest.NewStorageHost().WithNonStorageExtension("test"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			st := newDiskStorage(zap.NewNop(), tt.storageID, component.NewID(metadata.Type))
+			assert.Error(t, st.start(context.Background(), tt.host))
+			assert.NoError(t, st.shutdown())
+		})
+	}
+}
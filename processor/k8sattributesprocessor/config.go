@@ -59,7 +59,7 @@ type ExtractConfig struct {
 	//
 	// Metadata fields supported right now are,
 	//   k8s.pod.name, k8s.pod.uid, k8s.deployment.name,
-	//   k8s.node.name, k8s.namespace.name, k8s.pod.start_time,
+	//   k8s.node.name, k8s.node.uid, k8s.namespace.name, k8s.pod.start_time,
 	//   k8s.replicaset.name, k8s.replicaset.uid,
 	//   k8s.daemonset.name, k8s.daemonset.uid,
 	//   k8s.job.name, k8s.job.uid, k8s.cronjob.name,
@@ -80,26 +80,28 @@ type ExtractConfig struct {
 	//  - container.image.tag (requires one of the following additional attributes to be set: container.id or k8s.container.name)
 	Metadata []string `mapstructure:"metadata"`
 
-	// Annotations allows extracting data from pod annotations and record it
+	// Annotations allows extracting data from pod, namespace or node annotations and record it
 	// as resource attributes.
 	// It is a list of FieldExtractConfig type. See FieldExtractConfig
 	// documentation for more details.
 	Annotations []FieldExtractConfig `mapstructure:"annotations"`
 
-	// Labels allows extracting data from pod labels and record it
+	// Labels allows extracting data from pod, namespace or node labels and record it
 	// as resource attributes.
 	// It is a list of FieldExtractConfig type. See FieldExtractConfig
 	// documentation for more details.
 	Labels []FieldExtractConfig `mapstructure:"labels"`
 }
 
-// FieldExtractConfig allows specifying an extraction rule to extract a resource attribute from pod (or namespace)
+// FieldExtractConfig allows specifying an extraction rule to extract a resource attribute from pod (or namespace, or node)
 // annotations (or labels).
 type FieldExtractConfig struct {
 	// TagName represents the name of the resource attribute that will be added to logs, metrics or spans.
 	// When not specified, a default tag name will be used of the format:
 	//   - k8s.pod.annotations.<annotation key>
 	//   - k8s.pod.labels.<label key>
+	//   - k8s.namespace.annotations.<annotation key> or k8s.namespace.labels.<label key> when from is namespace
+	//   - k8s.node.annotations.<annotation key> or k8s.node.labels.<label key> when from is node
 	// For example, if tag_name is not specified and the key is git_sha,
 	// then the attribute name will be `k8s.pod.annotations.git_sha`.
 	// When key_regex is present, tag_name supports back reference to both named capturing and positioned capturing.
@@ -147,7 +149,8 @@ type FieldExtractConfig struct {
 	Regex string `mapstructure:"regex"`
 
 	// From represents the source of the labels/annotations.
-	// Allowed values are "pod" and "namespace". The default is pod.
+	// Allowed values are "pod", "namespace" and "node". The default is pod.
+	// Node labels and annotations are looked up by the k8s.node.name resource attribute.
 	From string `mapstructure:"from"`
 }
 
@@ -115,12 +115,12 @@ func namespaceAddAndUpdateTest(t *testing.T, c *WatchClient, handler func(obj in
 }
 
 func TestDefaultClientset(t *testing.T) {
-	c, err := New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, nil, nil, nil, nil)
+	c, err := New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, nil, nil, nil, nil, nil)
 	assert.Error(t, err)
 	assert.Equal(t, "invalid authType for kubernetes: ", err.Error())
 	assert.Nil(t, c)
 
-	c, err = New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, nil, nil, nil)
+	c, err = New(zap.NewNop(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, newFakeAPIClientset, nil, nil, nil, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, c)
 }
@@ -137,6 +137,7 @@ func TestBadFilters(t *testing.T) {
 		NewFakeInformer,
 		NewFakeNamespaceInformer,
 		NewFakeReplicaSetInformer,
+		NewFakeNodeInformer,
 	)
 	assert.Error(t, err)
 	assert.Nil(t, c)
@@ -173,7 +174,7 @@ func TestConstructorErrors(t *testing.T) {
 			gotAPIConfig = c
 			return nil, fmt.Errorf("error creating k8s client")
 		}
-		c, err := New(zap.NewNop(), apiCfg, er, ff, []Association{}, Excludes{}, clientProvider, NewFakeInformer, NewFakeNamespaceInformer, nil)
+		c, err := New(zap.NewNop(), apiCfg, er, ff, []Association{}, Excludes{}, clientProvider, NewFakeInformer, NewFakeNamespaceInformer, nil, nil)
 		assert.Nil(t, c)
 		assert.Error(t, err)
 		assert.Equal(t, "error creating k8s client", err.Error())
@@ -191,6 +192,44 @@ func TestNamespaceAdd(t *testing.T) {
 	namespaceAddAndUpdateTest(t, c, c.handleNamespaceAdd)
 }
 
+func TestNodeHandler(t *testing.T) {
+	c, _ := newTestClient(t)
+	c.Rules = ExtractionRules{NodeUID: true}
+	assert.Equal(t, 0, len(c.Nodes))
+
+	// nodes without a name are ignored
+	c.handleNodeAdd(&api_v1.Node{})
+	assert.Equal(t, 0, len(c.Nodes))
+
+	node := &api_v1.Node{}
+	node.Name = "nodeA"
+	node.UID = "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"
+	c.handleNodeAdd(node)
+	assert.Equal(t, 1, len(c.Nodes))
+	got, ok := c.GetNode("nodeA")
+	require.True(t, ok)
+	assert.Equal(t, "nodeA", got.Name)
+	assert.Equal(t, "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", got.NodeUID)
+	assert.Equal(t, map[string]string{"k8s.node.uid": "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"}, got.Attributes)
+
+	updatedNode := node.DeepCopy()
+	updatedNode.UID = "ffffffff-bbbb-cccc-dddd-eeeeeeeeeeee"
+	c.handleNodeUpdate(node, updatedNode)
+	assert.Equal(t, 1, len(c.Nodes))
+	got, ok = c.GetNode("nodeA")
+	require.True(t, ok)
+	assert.Equal(t, "ffffffff-bbbb-cccc-dddd-eeeeeeeeeeee", got.NodeUID)
+
+	// objects of another type are ignored
+	c.handleNodeDelete(&api_v1.Pod{})
+	assert.Equal(t, 1, len(c.Nodes))
+
+	c.handleNodeDelete(updatedNode)
+	assert.Equal(t, 0, len(c.Nodes))
+	_, ok = c.GetNode("nodeA")
+	assert.False(t, ok)
+}
+
 func TestReplicaSetHandler(t *testing.T) {
 	c, _ := newTestClient(t)
 	assert.Equal(t, len(c.ReplicaSets), 0)
@@ -1537,6 +1576,182 @@ func TestErrorSelectorsFromFilters(t *testing.T) {
 	}
 }
 
+func TestNodeExtractionRules(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
+
+	node := &api_v1.Node{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:              "k8s-node-example",
+			UID:               "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			CreationTimestamp: meta_v1.Now(),
+			Labels: map[string]string{
+				"topology.kubernetes.io/zone":      "us-east-1a",
+				"node.kubernetes.io/instance-type": "m5.large",
+			},
+			Annotations: map[string]string{
+				"annotation1": "av1",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		rules      ExtractionRules
+		attributes map[string]string
+	}{{
+		name:       "no-rules",
+		rules:      ExtractionRules{},
+		attributes: nil,
+	}, {
+		name: "uid",
+		rules: ExtractionRules{
+			NodeUID: true,
+		},
+		attributes: map[string]string{
+			"k8s.node.uid": "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+		},
+	}, {
+		name: "labels",
+		rules: ExtractionRules{
+			Annotations: []FieldExtractionRule{{
+				Name: "a1",
+				Key:  "annotation1",
+				From: MetadataFromNode,
+			},
+			},
+			Labels: []FieldExtractionRule{{
+				Name: "zone",
+				Key:  "topology.kubernetes.io/zone",
+				From: MetadataFromNode,
+			},
+			},
+		},
+		attributes: map[string]string{
+			"zone": "us-east-1a",
+			"a1":   "av1",
+		},
+	}, {
+		name: "pod-labels-ignored",
+		rules: ExtractionRules{
+			Labels: []FieldExtractionRule{{
+				Name: "zone",
+				Key:  "topology.kubernetes.io/zone",
+				From: MetadataFromPod,
+			},
+			},
+		},
+		attributes: nil,
+	}, {
+		name: "all-labels",
+		rules: ExtractionRules{
+			Labels: []FieldExtractionRule{{
+				KeyRegex: regexp.MustCompile("^(?:.*kubernetes.io/.*)$"),
+				From:     MetadataFromNode,
+			},
+			},
+		},
+		attributes: map[string]string{
+			"k8s.node.labels.topology.kubernetes.io/zone":      "us-east-1a",
+			"k8s.node.labels.node.kubernetes.io/instance-type": "m5.large",
+		},
+	}, {
+		name: "labels-with-key-regex-reference",
+		rules: ExtractionRules{
+			Labels: []FieldExtractionRule{{
+				Name:                 "node.$1",
+				KeyRegex:             regexp.MustCompile("^(?:node.kubernetes.io/(.*))$"),
+				HasKeyRegexReference: true,
+				From:                 MetadataFromNode,
+			},
+			},
+		},
+		attributes: map[string]string{
+			"node.instance-type": "m5.large",
+		},
+	}, {
+		name: "all-annotations",
+		rules: ExtractionRules{
+			Annotations: []FieldExtractionRule{{
+				KeyRegex: regexp.MustCompile("^(?:an.*)$"),
+				From:     MetadataFromNode,
+			},
+			},
+		},
+		attributes: map[string]string{
+			"k8s.node.annotations.annotation1": "av1",
+		},
+	},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c.Rules = tc.rules
+			c.handleNodeAdd(node)
+			n, ok := c.GetNode(node.Name)
+			require.True(t, ok)
+
+			assert.Equal(t, len(tc.attributes), len(n.Attributes))
+			for k, v := range tc.attributes {
+				got, ok := n.Attributes[k]
+				assert.True(t, ok)
+				assert.Equal(t, v, got)
+			}
+		})
+	}
+}
+
+func TestExtractNodeLabelsAnnotations(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
+	testCases := []struct {
+		name              string
+		shouldExtractNode bool
+		rules             ExtractionRules
+	}{{
+		name:              "empty-rules",
+		shouldExtractNode: false,
+		rules:             ExtractionRules{},
+	}, {
+		name:              "namespace-rules",
+		shouldExtractNode: false,
+		rules: ExtractionRules{
+			Labels: []FieldExtractionRule{{
+				Name: "l1",
+				Key:  "label1",
+				From: MetadataFromNamespace,
+			},
+			},
+		},
+	}, {
+		name:              "node-rules-only-annotations",
+		shouldExtractNode: true,
+		rules: ExtractionRules{
+			Annotations: []FieldExtractionRule{{
+				Name: "a1",
+				Key:  "annotation1",
+				From: MetadataFromNode,
+			},
+			},
+		},
+	}, {
+		name:              "node-rules-only-labels",
+		shouldExtractNode: true,
+		rules: ExtractionRules{
+			Labels: []FieldExtractionRule{{
+				Name: "l1",
+				Key:  "label1",
+				From: MetadataFromNode,
+			},
+			},
+		},
+	},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c.Rules = tc.rules
+			assert.Equal(t, tc.shouldExtractNode, c.extractNodeLabelsAnnotations())
+		})
+	}
+}
+
 func TestExtractNamespaceLabelsAnnotations(t *testing.T) {
 	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
 	testCases := []struct {
@@ -1622,7 +1837,7 @@ func newTestClientWithRulesAndFilters(t *testing.T, f Filters) (*WatchClient, *o
 			},
 		},
 	}
-	c, err := New(logger, k8sconfig.APIConfig{}, ExtractionRules{}, f, associations, exclude, newFakeAPIClientset, NewFakeInformer, NewFakeNamespaceInformer, NewFakeReplicaSetInformer)
+	c, err := New(logger, k8sconfig.APIConfig{}, ExtractionRules{}, f, associations, exclude, newFakeAPIClientset, NewFakeInformer, NewFakeNamespaceInformer, NewFakeReplicaSetInformer, NewFakeNodeInformer)
 	require.NoError(t, err)
 	return c.(*WatchClient), logs
 }
@@ -118,6 +118,36 @@ func (f *FakeReplicaSetInformer) GetController() cache.Controller {
 	return f.FakeController
 }
 
+type FakeNodeInformer struct {
+	*FakeController
+}
+
+func NewFakeNodeInformer(
+	_ kubernetes.Interface,
+	nodeName string,
+) cache.SharedInformer {
+	return &FakeInformer{
+		FakeController: &FakeController{},
+	}
+}
+
+func (f *FakeNodeInformer) AddEventHandler(handler cache.ResourceEventHandler) {}
+
+func (f *FakeNodeInformer) AddEventHandlerWithResyncPeriod(handler cache.ResourceEventHandler, period time.Duration) {
+}
+
+func (f *FakeNodeInformer) SetTransform(handler cache.TransformFunc) error {
+	return nil
+}
+
+func (f *FakeNodeInformer) GetStore() cache.Store {
+	return cache.NewStore(func(obj interface{}) (string, error) { return "", nil })
+}
+
+func (f *FakeNodeInformer) GetController() cache.Controller {
+	return f.FakeController
+}
+
 type FakeController struct {
 	sync.Mutex
 	stopped bool
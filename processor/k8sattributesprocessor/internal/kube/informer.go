@@ -39,6 +39,13 @@ type InformerProviderReplicaSet func(
 	namespace string,
 ) cache.SharedInformer
 
+// InformerProviderNode defines a function type that returns a new SharedInformer. It is used to
+// allow passing custom shared informers to the watch client for fetching node objects.
+type InformerProviderNode func(
+	client kubernetes.Interface,
+	nodeName string,
+) cache.SharedInformer
+
 func newSharedInformer(
 	client kubernetes.Interface,
 	namespace string,
@@ -126,3 +133,37 @@ func replicasetWatchFuncWithSelectors(client kubernetes.Interface, namespace str
 		return client.AppsV1().ReplicaSets(namespace).Watch(context.Background(), opts)
 	}
 }
+
+func newNodeSharedInformer(
+	client kubernetes.Interface,
+	nodeName string,
+) cache.SharedInformer {
+	informer := cache.NewSharedInformer(
+		&cache.ListWatch{
+			ListFunc:  nodeInformerListFunc(client, nodeName),
+			WatchFunc: nodeInformerWatchFunc(client, nodeName),
+		},
+		&api_v1.Node{},
+		watchSyncPeriod,
+	)
+	return informer
+}
+
+// nodeInformerListFunc lists all the nodes, or only the node the pods are filtered by when nodeName is set.
+func nodeInformerListFunc(client kubernetes.Interface, nodeName string) cache.ListFunc {
+	return func(opts metav1.ListOptions) (runtime.Object, error) {
+		if nodeName != "" {
+			opts.FieldSelector = fields.OneTermEqualSelector(nodeNameField, nodeName).String()
+		}
+		return client.CoreV1().Nodes().List(context.Background(), opts)
+	}
+}
+
+func nodeInformerWatchFunc(client kubernetes.Interface, nodeName string) cache.WatchFunc {
+	return func(opts metav1.ListOptions) (watch.Interface, error) {
+		if nodeName != "" {
+			opts.FieldSelector = fields.OneTermEqualSelector(nodeNameField, nodeName).String()
+		}
+		return client.CoreV1().Nodes().Watch(context.Background(), opts)
+	}
+}
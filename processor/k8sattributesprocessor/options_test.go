@@ -237,6 +237,34 @@ func TestWithExtractLabels(t *testing.T) {
 			},
 			"",
 		},
+		{
+			"basic-node",
+			[]FieldExtractConfig{
+				{
+					Key:  "topology.kubernetes.io/zone",
+					From: kube.MetadataFromNode,
+				},
+			},
+			[]kube.FieldExtractionRule{
+				{
+					Name: "k8s.node.labels.topology.kubernetes.io/zone",
+					Key:  "topology.kubernetes.io/zone",
+					From: kube.MetadataFromNode,
+				},
+			},
+			"",
+		},
+		{
+			"bad-from",
+			[]FieldExtractConfig{
+				{
+					Key:  "key1",
+					From: "cluster",
+				},
+			},
+			[]kube.FieldExtractionRule{},
+			"cluster is not a valid choice for From. Must be one of: pod, namespace, node",
+		},
 		{
 			"basic-pod-keyregex",
 			[]FieldExtractConfig{
@@ -314,6 +342,12 @@ func TestWithExtractMetadata(t *testing.T) {
 	assert.False(t, p.rules.StartTime)
 	assert.False(t, p.rules.DeploymentName)
 	assert.False(t, p.rules.Node)
+
+	p = &kubernetesprocessor{}
+	assert.NoError(t, withExtractMetadata(conventions.AttributeK8SNodeName, conventions.AttributeK8SNodeUID)(p))
+	assert.True(t, p.rules.Node)
+	assert.True(t, p.rules.NodeUID)
+	assert.False(t, p.rules.PodName)
 }
 
 func TestWithFilterLabels(t *testing.T) {
@@ -19,6 +19,15 @@ func createRouteFunction[K any](_ ottl.FunctionContext, _ ottl.Arguments) (ottl.
 func Functions[K any]() map[string]ottl.Factory[K] {
 	return ottl.CreateFactoryMap(
 		ottlfuncs.NewIsMatchFactory[K](),
+		ottlfuncs.NewTimeFactory[K](),
+		ottlfuncs.NewFormatTimeFactory[K](),
+		ottlfuncs.NewNowFactory[K](),
+		ottlfuncs.NewDurationFactory[K](),
+		ottlfuncs.NewUnixFactory[K](),
+		ottlfuncs.NewUnixSecondsFactory[K](),
+		ottlfuncs.NewUnixMilliFactory[K](),
+		ottlfuncs.NewUnixMicroFactory[K](),
+		ottlfuncs.NewUnixNanoFactory[K](),
 		ottlfuncs.NewDeleteKeyFactory[K](),
 		ottlfuncs.NewDeleteMatchingKeysFactory[K](),
 		// noop function, it is required since the parsing of conditions is not implemented yet,
@@ -27,6 +27,12 @@ type Config struct {
 	// block processing of signals. (Optional field)
 	Prefetch []string `mapstructure:"prefetch"`
 
+	// CacheDirectory is the directory used to store the
+	// retrieved schema files, so that they are not fetched
+	// again once the collector restarts. When left empty,
+	// the schema files are only cached in memory. (Optional field)
+	CacheDirectory string `mapstructure:"cache_directory"`
+
 	// Targets define what schema families should be
 	// translated to, allowing older and newer formats
 	// to conform to the target schema identifier.
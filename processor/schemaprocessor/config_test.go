@@ -29,11 +29,14 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, component.UnmarshalConfig(sub, cfg))
 
+	httpSettings := confighttp.NewDefaultHTTPClientSettings()
+	httpSettings.Timeout = defaultTimeout
 	assert.Equal(t, &Config{
-		HTTPClientSettings: confighttp.NewDefaultHTTPClientSettings(),
+		HTTPClientSettings: httpSettings,
 		Prefetch: []string{
 			"https://opentelemetry.io/schemas/1.9.0",
 		},
+		CacheDirectory: "/var/lib/otelcol/schemas",
 		Targets: []string{
 			"https://opentelemetry.io/schemas/1.4.2",
 			"https://example.com/otel/schemas/1.2.0",
@@ -5,6 +5,7 @@ package schemaprocessor // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -17,6 +18,9 @@ const (
 	typeStr = "schema"
 	// The stability level of the processor.
 	stability = component.StabilityLevelDevelopment
+	// defaultTimeout bounds the retrieval of a schema file,
+	// since the default http client settings never time out.
+	defaultTimeout = 10 * time.Second
 )
 
 var processorCapabilities = consumer.Capabilities{MutatesData: true}
@@ -27,8 +31,10 @@ type factory struct{}
 // newDefaultConfiguration returns the configuration for schema transformer processor
 // with the default values being used throughout it
 func newDefaultConfiguration() component.Config {
+	httpSettings := confighttp.NewDefaultHTTPClientSettings()
+	httpSettings.Timeout = defaultTimeout
 	return &Config{
-		HTTPClientSettings: confighttp.NewDefaultHTTPClientSettings(),
+		HTTPClientSettings: httpSettings,
 	}
 }
 
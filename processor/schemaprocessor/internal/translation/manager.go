@@ -0,0 +1,235 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// initialRetryBackoff is how long the manager waits before
+	// retrying a schema file that failed to load, doubled on
+	// every consecutive failure up to maxRetryBackoff.
+	initialRetryBackoff = 5 * time.Second
+	maxRetryBackoff     = 5 * time.Minute
+)
+
+var errNilProvider = errors.New("no schema provider set")
+
+// Manager is responsible for ensuring that schemas are kept up to date
+// with the most recent version that are requested.
+type Manager interface {
+	// RequestTranslation will provide either the defined Translation
+	// if it is a known target, or, return a noop variation.
+	// When the schema file needed by the translation is not loaded yet,
+	// it is fetched in the background and a noop variation is returned
+	// until it is ready, so this never blocks on the provider.
+	RequestTranslation(ctx context.Context, schemaURL string) Translation
+
+	// Prefetch loads the schema file needed to translate the
+	// schemaURL, waiting until it is loaded or the context is done.
+	Prefetch(ctx context.Context, schemaURL string)
+
+	// SetProvider will update the provider used by the manager
+	// to retrieve the schema files that are not loaded yet.
+	SetProvider(p Provider)
+}
+
+// fetch tracks the retrieval of a schema file so that concurrent
+// requests share it and failures are not retried on every signal.
+type fetch struct {
+	// done is closed once the in flight retrieval completes,
+	// and is nil while no retrieval is in flight.
+	done     chan struct{}
+	loaded   bool
+	failures int
+	retryAt  time.Time
+}
+
+type manager struct {
+	log *zap.Logger
+	now func() time.Time
+
+	rw           sync.RWMutex
+	provider     Provider
+	targets      map[string]*Version
+	translations map[string]*translator
+	fetches      map[string]*fetch
+}
+
+var _ Manager = (*manager)(nil)
+
+// NewManager creates a manager that will allow for management
+// of schema, the options allow for additional properties to be
+// added to manager to enable additional locations of where to check
+// for translations file.
+func NewManager(targets []string, log *zap.Logger) (Manager, error) {
+	if log == nil {
+		log = zap.NewNop()
+	}
+
+	match := make(map[string]*Version, len(targets))
+	for _, target := range targets {
+		family, version, err := GetFamilyAndVersion(target)
+		if err != nil {
+			return nil, err
+		}
+		match[family] = version
+	}
+
+	return &manager{
+		log:          log,
+		now:          time.Now,
+		targets:      match,
+		translations: make(map[string]*translator, len(targets)),
+		fetches:      make(map[string]*fetch),
+	}, nil
+}
+
+func (m *manager) RequestTranslation(_ context.Context, schemaURL string) Translation {
+	trans, _ := m.lookupTranslation(schemaURL)
+	return trans
+}
+
+func (m *manager) Prefetch(ctx context.Context, schemaURL string) {
+	_, loading := m.lookupTranslation(schemaURL)
+	if loading == nil {
+		return
+	}
+	select {
+	case <-loading:
+	case <-ctx.Done():
+	}
+}
+
+func (m *manager) SetProvider(p Provider) {
+	m.rw.Lock()
+	m.provider = p
+	m.rw.Unlock()
+}
+
+// lookupTranslation returns the translation for the schemaURL and,
+// when its schema file is being loaded, a channel closed once it is done.
+func (m *manager) lookupTranslation(schemaURL string) (Translation, <-chan struct{}) {
+	family, version, err := GetFamilyAndVersion(schemaURL)
+	if err != nil {
+		m.log.Debug("No valid schema url was provided, using no-op schema",
+			zap.String("schema-url", schemaURL),
+		)
+		return nopTranslation{}, nil
+	}
+
+	m.rw.RLock()
+	target, match := m.targets[family]
+	trans, exist := m.translations[family]
+	m.rw.RUnlock()
+
+	if !match {
+		m.log.Debug("Not a known target, providing Nop Translation",
+			zap.String("schema-url", schemaURL),
+		)
+		return nopTranslation{}, nil
+	}
+	if exist && trans.SupportedVersion(version) {
+		return trans, nil
+	}
+
+	// The schema file of a version only defines the versions up to itself,
+	// so signals newer than the target require their own schema file.
+	fetchURL := joinSchemaFamilyAndVersion(family, target)
+	if version.GreaterThan(target) {
+		fetchURL = schemaURL
+	}
+	return nopTranslation{}, m.startFetch(family, fetchURL, joinSchemaFamilyAndVersion(family, target))
+}
+
+// startFetch loads the schema file at fetchURL in the background, unless
+// it is already loaded, in flight, or waiting to be retried after a failure.
+// It returns the channel closed once the retrieval in flight is done, if any.
+func (m *manager) startFetch(family, fetchURL, targetSchemaURL string) <-chan struct{} {
+	m.rw.Lock()
+	defer m.rw.Unlock()
+
+	if m.provider == nil {
+		m.log.Debug("No provider set, providing Nop Translation",
+			zap.String("schema-url", fetchURL),
+		)
+		return nil
+	}
+
+	f, exist := m.fetches[fetchURL]
+	switch {
+	case !exist:
+		f = &fetch{}
+		m.fetches[fetchURL] = f
+	case f.done != nil:
+		return f.done
+	case f.loaded, m.now().Before(f.retryAt):
+		return nil
+	}
+
+	f.done = make(chan struct{})
+	go m.fetchTranslation(f, m.provider, family, fetchURL, targetSchemaURL)
+	return f.done
+}
+
+func (m *manager) fetchTranslation(f *fetch, p Provider, family, fetchURL, targetSchemaURL string) {
+	// The retrieval is shared by every request of the schema file,
+	// so it must not be cancelled along with the one that started it.
+	trans, err := m.loadTranslation(context.Background(), p, fetchURL, targetSchemaURL)
+
+	m.rw.Lock()
+	defer m.rw.Unlock()
+	defer func() {
+		close(f.done)
+		f.done = nil
+	}()
+
+	if err != nil {
+		f.failures++
+		backoff := maxRetryBackoff
+		if shift := f.failures - 1; shift < 16 && initialRetryBackoff<<shift < maxRetryBackoff {
+			backoff = initialRetryBackoff << shift
+		}
+		f.retryAt = m.now().Add(backoff)
+		m.log.Error("Failed to retrieve translation, providing Nop Translation",
+			zap.String("schema-url", fetchURL),
+			zap.Duration("retry-in", backoff),
+			zap.Error(err),
+		)
+		return
+	}
+	f.loaded = true
+
+	// Keep the translation that covers the most versions, so that
+	// concurrent loads of older versions do not replace it.
+	if current, exist := m.translations[family]; !exist || !current.SupportedVersion(trans.revisions[len(trans.revisions)-1].ver) {
+		m.translations[family] = trans
+	}
+}
+
+func (m *manager) loadTranslation(ctx context.Context, p Provider, schemaURL, targetSchemaURL string) (*translator, error) {
+	if p == nil {
+		return nil, errNilProvider
+	}
+	content, err := p.Retrieve(ctx, schemaURL)
+	if err != nil {
+		return nil, err
+	}
+	return newTranslatorFromReader(
+		m.log.With(zap.String("family", targetSchemaURL)),
+		targetSchemaURL,
+		strings.NewReader(content),
+	)
+}
+
+func joinSchemaFamilyAndVersion(family string, version *Version) string {
+	return family + "/" + version.String()
+}
@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestNewManagerInvalidTarget(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewManager([]string{"not a schema url"}, zaptest.NewLogger(t))
+	assert.Error(t, err, "Must error with an invalid target")
+}
+
+func TestManagerRequestTranslation(t *testing.T) {
+	t.Parallel()
+
+	s, requests := newSchemaServer(t, string(loadSchemaContent(t)))
+
+	tests := []struct {
+		scenario  string
+		target    string
+		schemaURL string
+		nop       bool
+	}{
+		{scenario: "unknown family", target: s.URL + "/schemas/1.1.0", schemaURL: "https://example.com/schemas/1.0.0", nop: true},
+		{scenario: "invalid schema url", target: s.URL + "/schemas/1.1.0", schemaURL: "", nop: true},
+		{scenario: "upgrade using the target schema", target: s.URL + "/schemas/1.1.0", schemaURL: s.URL + "/schemas/1.0.0"},
+		{scenario: "downgrade using the signal schema", target: s.URL + "/schemas/1.0.0", schemaURL: s.URL + "/schemas/1.1.0"},
+		{scenario: "unpublished schema", target: s.URL + "/schemas/1.1.0", schemaURL: s.URL + "/schemas/1.2.0", nop: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scenario, func(t *testing.T) {
+			m, err := NewManager([]string{tc.target}, zaptest.NewLogger(t))
+			require.NoError(t, err, "Must not error when creating manager")
+			m.SetProvider(NewHTTPProvider(s.Client()))
+
+			m.Prefetch(context.Background(), tc.schemaURL)
+			tn := m.RequestTranslation(context.Background(), tc.schemaURL)
+			if tc.nop {
+				assert.IsType(t, nopTranslation{}, tn, "Must return a nop translation")
+				return
+			}
+			require.IsType(t, &translator{}, tn, "Must return a schema translation")
+			assert.Equal(t, tc.target, tn.(*translator).targetSchemaURL)
+			assert.Same(t, tn, m.RequestTranslation(context.Background(), tc.schemaURL), "Must reuse the loaded translation")
+		})
+	}
+	assert.EqualValues(t, 3, requests.Load(), "Must only fetch each translation once")
+}
+
+func TestManagerWithoutProvider(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewManager([]string{"https://example.com/schemas/1.1.0"}, zaptest.NewLogger(t))
+	require.NoError(t, err, "Must not error when creating manager")
+
+	tn := m.RequestTranslation(context.Background(), "https://example.com/schemas/1.0.0")
+	assert.IsType(t, nopTranslation{}, tn, "Must return a nop translation without a provider")
+}
+
+// blockingProvider counts the retrievals, which are held
+// until release is closed and then return the content or err.
+type blockingProvider struct {
+	release  chan struct{}
+	content  string
+	err      error
+	requests atomic.Int64
+}
+
+func (bp *blockingProvider) Retrieve(_ context.Context, _ string) (string, error) {
+	bp.requests.Add(1)
+	<-bp.release
+	return bp.content, bp.err
+}
+
+func TestManagerFetchesInBackground(t *testing.T) {
+	t.Parallel()
+
+	const schemaURL = "https://example.com/schemas/1.0.0"
+
+	m, err := NewManager([]string{"https://example.com/schemas/1.1.0"}, zaptest.NewLogger(t))
+	require.NoError(t, err, "Must not error when creating manager")
+	p := &blockingProvider{release: make(chan struct{}), content: string(loadSchemaContent(t))}
+	m.SetProvider(p)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tn := m.RequestTranslation(context.Background(), schemaURL)
+			assert.IsType(t, nopTranslation{}, tn, "Must pass signals through while the schema is loading")
+		}()
+	}
+	wg.Wait()
+
+	close(p.release)
+	m.Prefetch(context.Background(), schemaURL)
+	assert.IsType(t, &translator{}, m.RequestTranslation(context.Background(), schemaURL), "Must return the schema translation once loaded")
+	assert.EqualValues(t, 1, p.requests.Load(), "Must share the retrieval between concurrent requests")
+}
+
+func TestManagerRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	const schemaURL = "https://example.com/schemas/1.0.0"
+
+	tm, err := NewManager([]string{"https://example.com/schemas/1.1.0"}, zaptest.NewLogger(t))
+	require.NoError(t, err, "Must not error when creating manager")
+	p := &blockingProvider{release: make(chan struct{}), err: errors.New("unavailable")}
+	close(p.release)
+	tm.SetProvider(p)
+
+	now := time.Now()
+	m := tm.(*manager)
+	m.now = func() time.Time { return now }
+
+	for _, step := range []struct {
+		elapsed  time.Duration
+		requests int64
+	}{
+		{elapsed: 0, requests: 1},
+		{elapsed: initialRetryBackoff - time.Second, requests: 1},
+		{elapsed: time.Second, requests: 2},
+		{elapsed: initialRetryBackoff, requests: 2},
+		{elapsed: initialRetryBackoff, requests: 3},
+	} {
+		now = now.Add(step.elapsed)
+		m.Prefetch(context.Background(), schemaURL)
+		assert.IsType(t, nopTranslation{}, m.RequestTranslation(context.Background(), schemaURL), "Must pass signals through when the schema fails to load")
+		assert.Equal(t, step.requests, p.requests.Load(), "Must back off after failing to retrieve the schema")
+	}
+}
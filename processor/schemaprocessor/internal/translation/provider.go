@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	schema "go.opentelemetry.io/otel/schema/v1.0"
+)
+
+// maxSchemaFileSize bounds the size of a retrieved schema file,
+// published schema files being a few kilobytes at most.
+const maxSchemaFileSize = 4 << 20
+
+// Provider allows for collector extensions to be used to look up schemaURLs
+type Provider interface {
+	// Retrieve returns the content of the schema file
+	// published at the given schemaURL
+	Retrieve(ctx context.Context, schemaURL string) (string, error)
+}
+
+type httpProvider struct {
+	client *http.Client
+}
+
+var _ Provider = (*httpProvider)(nil)
+
+// NewHTTPProvider returns a provider that fetches the
+// schema files over http using the given client.
+func NewHTTPProvider(client *http.Client) Provider {
+	return &httpProvider{client: client}
+}
+
+func (hp *httpProvider) Retrieve(ctx context.Context, schemaURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, schemaURL, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	resp, err := hp.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("invalid status code returned for %q: %d", schemaURL, resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxSchemaFileSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(content) > maxSchemaFileSize {
+		return "", fmt.Errorf("schema file %q is larger than %d bytes", schemaURL, maxSchemaFileSize)
+	}
+	return string(content), nil
+}
+
+type cacheProvider struct {
+	dir  string
+	next Provider
+
+	rw      sync.RWMutex
+	content map[string]string
+}
+
+var _ Provider = (*cacheProvider)(nil)
+
+// NewCacheProvider returns a provider that keeps the schema files
+// retrieved by next in memory and, when dir is not empty, on disk
+// so that they are not fetched again once the collector restarts.
+// Published schema files are immutable, so cached entries never expire,
+// and only content that parses as a schema file is cached.
+func NewCacheProvider(dir string, next Provider) Provider {
+	return &cacheProvider{
+		dir:     dir,
+		next:    next,
+		content: make(map[string]string),
+	}
+}
+
+func (cp *cacheProvider) Retrieve(ctx context.Context, schemaURL string) (string, error) {
+	cp.rw.RLock()
+	content, exist := cp.content[schemaURL]
+	cp.rw.RUnlock()
+	if exist {
+		return content, nil
+	}
+
+	content, err := cp.readFile(schemaURL)
+	if err == nil {
+		err = validateSchemaFile(content)
+	}
+	if err != nil {
+		content, err = cp.next.Retrieve(ctx, schemaURL)
+		if err != nil {
+			return "", err
+		}
+		if err = validateSchemaFile(content); err != nil {
+			return "", fmt.Errorf("invalid schema file %q: %w", schemaURL, err)
+		}
+		// Failing to persist the schema file only means that
+		// it will be fetched again on the next restart.
+		_ = cp.writeFile(schemaURL, content)
+	}
+
+	cp.rw.Lock()
+	cp.content[schemaURL] = content
+	cp.rw.Unlock()
+
+	return content, nil
+}
+
+func (cp *cacheProvider) readFile(schemaURL string) (string, error) {
+	if cp.dir == "" {
+		return "", os.ErrNotExist
+	}
+	content, err := os.ReadFile(cp.filename(schemaURL))
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+func (cp *cacheProvider) writeFile(schemaURL, content string) error {
+	if cp.dir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(cp.dir, 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(cp.filename(schemaURL), []byte(content), 0600)
+}
+
+// validateSchemaFile ensures that content, such as an error page
+// returned with a successful status, is not cached as a schema file.
+func validateSchemaFile(content string) error {
+	_, err := schema.Parse(strings.NewReader(content))
+	return err
+}
+
+// filename returns the file used to cache the schemaURL,
+// which is hashed since it may contain characters not allowed in paths.
+func (cp *cacheProvider) filename(schemaURL string) string {
+	sum := sha256.Sum256([]byte(schemaURL))
+	return filepath.Join(cp.dir, hex.EncodeToString(sum[:])+".yaml")
+}
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSchemaServer(t *testing.T, content string) (*httptest.Server, *atomic.Int64) {
+	var requests atomic.Int64
+	s := httptest.NewServer(http.HandlerFunc(func(wr http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != "/schemas/1.1.0" {
+			wr.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := wr.Write([]byte(content))
+		assert.NoError(t, err, "Must not have issues writing schema content")
+	}))
+	t.Cleanup(s.Close)
+	return s, &requests
+}
+
+func TestHTTPProvider(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newSchemaServer(t, "schema content")
+	p := NewHTTPProvider(s.Client())
+
+	content, err := p.Retrieve(context.Background(), s.URL+"/schemas/1.1.0")
+	require.NoError(t, err, "Must not error when retrieving a published schema")
+	assert.Equal(t, "schema content", content)
+
+	_, err = p.Retrieve(context.Background(), s.URL+"/schemas/1.2.0")
+	assert.Error(t, err, "Must error when the schema is not published")
+}
+
+func TestCacheProvider(t *testing.T) {
+	t.Parallel()
+
+	content := string(loadSchemaContent(t))
+	s, requests := newSchemaServer(t, content)
+	dir := t.TempDir()
+	schemaURL := s.URL + "/schemas/1.1.0"
+
+	p := NewCacheProvider(dir, NewHTTPProvider(s.Client()))
+	for i := 0; i < 3; i++ {
+		retrieved, err := p.Retrieve(context.Background(), schemaURL)
+		require.NoError(t, err, "Must not error when retrieving a published schema")
+		assert.Equal(t, content, retrieved)
+	}
+	assert.EqualValues(t, 1, requests.Load(), "Must only fetch the schema once")
+
+	// A new provider, as created after a restart, reads the schema from the directory.
+	p = NewCacheProvider(dir, NewHTTPProvider(s.Client()))
+	retrieved, err := p.Retrieve(context.Background(), schemaURL)
+	require.NoError(t, err, "Must not error when reading a cached schema")
+	assert.Equal(t, content, retrieved)
+	assert.EqualValues(t, 1, requests.Load(), "Must read the cached schema from disk")
+
+	_, err = p.Retrieve(context.Background(), s.URL+"/schemas/1.2.0")
+	assert.Error(t, err, "Must error when the schema is not published")
+}
+
+func TestCacheProviderWithoutDirectory(t *testing.T) {
+	t.Parallel()
+
+	s, requests := newSchemaServer(t, string(loadSchemaContent(t)))
+	schemaURL := s.URL + "/schemas/1.1.0"
+
+	p := NewCacheProvider("", NewHTTPProvider(s.Client()))
+	for i := 0; i < 2; i++ {
+		_, err := p.Retrieve(context.Background(), schemaURL)
+		require.NoError(t, err, "Must not error when retrieving a published schema")
+	}
+	assert.EqualValues(t, 1, requests.Load(), "Must keep the schema in memory")
+}
+
+func TestHTTPProviderTooLarge(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newSchemaServer(t, strings.Repeat("a", maxSchemaFileSize+1))
+	p := NewHTTPProvider(s.Client())
+
+	_, err := p.Retrieve(context.Background(), s.URL+"/schemas/1.1.0")
+	assert.ErrorContains(t, err, "is larger than", "Must error when the schema file is too large")
+}
+
+func TestCacheProviderInvalidContent(t *testing.T) {
+	t.Parallel()
+
+	var valid atomic.Bool
+	var requests atomic.Int64
+	content := string(loadSchemaContent(t))
+	s := httptest.NewServer(http.HandlerFunc(func(wr http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if !valid.Load() {
+			_, _ = wr.Write([]byte("<html>proxy error</html>"))
+			return
+		}
+		_, _ = wr.Write([]byte(content))
+	}))
+	t.Cleanup(s.Close)
+
+	dir := t.TempDir()
+	schemaURL := s.URL + "/schemas/1.1.0"
+	p := NewCacheProvider(dir, NewHTTPProvider(s.Client()))
+
+	_, err := p.Retrieve(context.Background(), schemaURL)
+	assert.ErrorContains(t, err, "invalid schema file", "Must error when the content is not a schema file")
+	_, err = p.Retrieve(context.Background(), schemaURL)
+	assert.Error(t, err, "Must not cache content that is not a schema file")
+	assert.EqualValues(t, 2, requests.Load(), "Must fetch the schema again after invalid content")
+
+	// A corrupted file on disk is replaced by the published schema.
+	require.NoError(t, os.WriteFile(p.(*cacheProvider).filename(schemaURL), []byte("truncated: ["), 0600))
+	valid.Store(true)
+	retrieved, err := p.Retrieve(context.Background(), schemaURL)
+	require.NoError(t, err, "Must recover once the published schema is retrieved")
+	assert.Equal(t, content, retrieved)
+	assert.EqualValues(t, 3, requests.Load())
+
+	p = NewCacheProvider(dir, NewHTTPProvider(s.Client()))
+	retrieved, err = p.Retrieve(context.Background(), schemaURL)
+	require.NoError(t, err, "Must read the valid schema from disk")
+	assert.Equal(t, content, retrieved)
+	assert.EqualValues(t, 3, requests.Load(), "Must not fetch the schema once cached on disk")
+}
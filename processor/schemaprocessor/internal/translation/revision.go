@@ -0,0 +1,292 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/otel/schema/v1.0/ast"
+)
+
+// renames maps the names used in the previous version
+// to the names used starting from the revision.
+type renames map[string]string
+
+// lookup returns the name that replaces name, when upgrading
+// it follows the map from key to value, when downgrading
+// it follows the map from value to key.
+func (r renames) lookup(name string, upgrade bool) (string, bool) {
+	if upgrade {
+		to, ok := r[name]
+		return to, ok
+	}
+	for from, to := range r {
+		if to == name {
+			return from, true
+		}
+	}
+	return "", false
+}
+
+// applyTo moves the values stored under the renamed attributes.
+// Attributes that would override an existing value are left untouched
+// so that no data is lost.
+func (r renames) applyTo(attrs pcommon.Map, upgrade bool) {
+	for from, to := range r {
+		if !upgrade {
+			from, to = to, from
+		}
+		val, exist := attrs.Get(from)
+		if !exist {
+			continue
+		}
+		if _, conflict := attrs.Get(to); conflict {
+			continue
+		}
+		moved := pcommon.NewValueEmpty()
+		val.CopyTo(moved)
+		attrs.Remove(from)
+		moved.CopyTo(attrs.PutEmpty(to))
+	}
+}
+
+// matcher restricts a change to the named signals,
+// an empty matcher applies to all of them.
+type matcher map[string]struct{}
+
+func (m matcher) matches(name string) bool {
+	if len(m) == 0 {
+		return true
+	}
+	_, ok := m[name]
+	return ok
+}
+
+func newMatcher[T ~string](names []T) matcher {
+	m := make(matcher, len(names))
+	for _, name := range names {
+		m[string(name)] = struct{}{}
+	}
+	return m
+}
+
+type spanChange struct {
+	spans      matcher
+	attributes renames
+}
+
+type spanEventChange struct {
+	spans      matcher
+	events     matcher
+	names      renames
+	attributes renames
+}
+
+type metricChange struct {
+	metrics    matcher
+	names      renames
+	attributes renames
+}
+
+// revision holds all the changes introduced by a schema version,
+// in the order they are defined within the schema file.
+type revision struct {
+	ver *Version
+
+	all        []renames
+	resources  []renames
+	spans      []spanChange
+	spanEvents []spanEventChange
+	metrics    []metricChange
+	logs       []renames
+}
+
+func newRevision(ver *Version, def ast.VersionDef) *revision {
+	r := &revision{ver: ver}
+	for _, c := range def.All.Changes {
+		if c.RenameAttributes != nil {
+			r.all = append(r.all, renames(c.RenameAttributes.AttributeMap))
+		}
+	}
+	for _, c := range def.Resources.Changes {
+		if c.RenameAttributes != nil {
+			r.resources = append(r.resources, renames(c.RenameAttributes.AttributeMap))
+		}
+	}
+	for _, c := range def.Spans.Changes {
+		if c.RenameAttributes != nil {
+			r.spans = append(r.spans, spanChange{
+				spans:      newMatcher(c.RenameAttributes.ApplyToSpans),
+				attributes: renames(c.RenameAttributes.AttributeMap),
+			})
+		}
+	}
+	for _, c := range def.SpanEvents.Changes {
+		if c.RenameEvents != nil {
+			r.spanEvents = append(r.spanEvents, spanEventChange{
+				names: renames(c.RenameEvents.EventNameMap),
+			})
+		}
+		if c.RenameAttributes != nil {
+			r.spanEvents = append(r.spanEvents, spanEventChange{
+				spans:      newMatcher(c.RenameAttributes.ApplyToSpans),
+				events:     newMatcher(c.RenameAttributes.ApplyToEvents),
+				attributes: renames(c.RenameAttributes.AttributeMap),
+			})
+		}
+	}
+	for _, c := range def.Metrics.Changes {
+		if len(c.RenameMetrics) > 0 {
+			names := make(renames, len(c.RenameMetrics))
+			for from, to := range c.RenameMetrics {
+				names[string(from)] = string(to)
+			}
+			r.metrics = append(r.metrics, metricChange{names: names})
+		}
+		if c.RenameAttributes != nil {
+			r.metrics = append(r.metrics, metricChange{
+				metrics:    newMatcher(c.RenameAttributes.ApplyToMetrics),
+				attributes: renames(c.RenameAttributes.AttributeMap),
+			})
+		}
+	}
+	for _, c := range def.Logs.Changes {
+		if c.RenameAttributes != nil {
+			r.logs = append(r.logs, renames(c.RenameAttributes.AttributeMap))
+		}
+	}
+	return r
+}
+
+// forEach calls fn with each change in the order defined
+// when upgrading, and in the reverse order when downgrading.
+func forEach[T any](changes []T, upgrade bool, fn func(T)) {
+	for i := range changes {
+		if !upgrade {
+			i = len(changes) - 1 - i
+		}
+		fn(changes[i])
+	}
+}
+
+// applyAll applies the changes that are shared by every data type,
+// they happen before the type specific changes when upgrading
+// and after them when downgrading.
+func (r *revision) applyAll(attrs pcommon.Map, upgrade bool) {
+	forEach(r.all, upgrade, func(c renames) {
+		c.applyTo(attrs, upgrade)
+	})
+}
+
+func (r *revision) applyResource(resource pcommon.Resource, upgrade bool) {
+	attrs := resource.Attributes()
+	if upgrade {
+		r.applyAll(attrs, upgrade)
+	}
+	forEach(r.resources, upgrade, func(c renames) {
+		c.applyTo(attrs, upgrade)
+	})
+	if !upgrade {
+		r.applyAll(attrs, upgrade)
+	}
+}
+
+func (r *revision) applySpan(span ptrace.Span, upgrade bool) {
+	if upgrade {
+		r.applyAll(span.Attributes(), upgrade)
+	}
+	forEach(r.spans, upgrade, func(c spanChange) {
+		if c.spans.matches(span.Name()) {
+			c.attributes.applyTo(span.Attributes(), upgrade)
+		}
+	})
+	if !upgrade {
+		r.applyAll(span.Attributes(), upgrade)
+	}
+
+	for i := 0; i < span.Events().Len(); i++ {
+		r.applySpanEvent(span.Name(), span.Events().At(i), upgrade)
+	}
+}
+
+func (r *revision) applySpanEvent(spanName string, event ptrace.SpanEvent, upgrade bool) {
+	if upgrade {
+		r.applyAll(event.Attributes(), upgrade)
+	}
+	forEach(r.spanEvents, upgrade, func(c spanEventChange) {
+		if name, ok := c.names.lookup(event.Name(), upgrade); ok {
+			event.SetName(name)
+		}
+		if c.spans.matches(spanName) && c.events.matches(event.Name()) {
+			c.attributes.applyTo(event.Attributes(), upgrade)
+		}
+	})
+	if !upgrade {
+		r.applyAll(event.Attributes(), upgrade)
+	}
+}
+
+func (r *revision) applyMetric(metric pmetric.Metric, upgrade bool) {
+	if upgrade {
+		forEachDataPointAttributes(metric, func(attrs pcommon.Map) {
+			r.applyAll(attrs, upgrade)
+		})
+	}
+	forEach(r.metrics, upgrade, func(c metricChange) {
+		if name, ok := c.names.lookup(metric.Name(), upgrade); ok {
+			metric.SetName(name)
+		}
+		if len(c.attributes) > 0 && c.metrics.matches(metric.Name()) {
+			forEachDataPointAttributes(metric, func(attrs pcommon.Map) {
+				c.attributes.applyTo(attrs, upgrade)
+			})
+		}
+	})
+	if !upgrade {
+		forEachDataPointAttributes(metric, func(attrs pcommon.Map) {
+			r.applyAll(attrs, upgrade)
+		})
+	}
+}
+
+func (r *revision) applyLog(log plog.LogRecord, upgrade bool) {
+	if upgrade {
+		r.applyAll(log.Attributes(), upgrade)
+	}
+	forEach(r.logs, upgrade, func(c renames) {
+		c.applyTo(log.Attributes(), upgrade)
+	})
+	if !upgrade {
+		r.applyAll(log.Attributes(), upgrade)
+	}
+}
+
+func forEachDataPointAttributes(metric pmetric.Metric, fn func(attrs pcommon.Map)) {
+	//exhaustive:enforce
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < metric.Gauge().DataPoints().Len(); i++ {
+			fn(metric.Gauge().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			fn(metric.Sum().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < metric.Histogram().DataPoints().Len(); i++ {
+			fn(metric.Histogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < metric.ExponentialHistogram().DataPoints().Len(); i++ {
+			fn(metric.ExponentialHistogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < metric.Summary().DataPoints().Len(); i++ {
+			fn(metric.Summary().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
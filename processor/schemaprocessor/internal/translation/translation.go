@@ -0,0 +1,207 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	schema "go.opentelemetry.io/otel/schema/v1.0"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/alias"
+)
+
+// Translation defines the complete abstraction of schema translation file
+// that is defined as part of the https://opentelemetry.io/docs/reference/specification/schemas/file_format_v1.0.0/
+// Each instance of Translation is "Target Aware", meaning that given a schemaURL as an input
+// it will convert from the given input, to the configured target.
+//
+// Note: as an optimisation, once a Translation is returned from the manager,
+// there is no checking the incoming signals if the schema family is a match.
+type Translation interface {
+	// SupportedVersion checks to see if the provided version is defined as part
+	// of this translation since it is useful to know if the translation is missing
+	// updates.
+	SupportedVersion(v *Version) bool
+
+	// ApplyAllResourceChanges will modify the resource part of the incoming signals
+	// from the version defined by inSchemaURL to the target version.
+	// This includes resource attributes and the schema URL of the resource.
+	ApplyAllResourceChanges(in alias.Resource, inSchemaURL string)
+
+	// ApplyScopeSpanChanges will modify all spans and span events from
+	// the version defined by inSchemaURL to the target version.
+	ApplyScopeSpanChanges(in ptrace.ScopeSpans, inSchemaURL string)
+
+	// ApplyScopeLogChanges will modify all logs from
+	// the version defined by inSchemaURL to the target version.
+	ApplyScopeLogChanges(in plog.ScopeLogs, inSchemaURL string)
+
+	// ApplyScopeMetricChanges will modify all metrics from
+	// the version defined by inSchemaURL to the target version.
+	ApplyScopeMetricChanges(in pmetric.ScopeMetrics, inSchemaURL string)
+}
+
+type translator struct {
+	targetSchemaURL string
+	target          *Version
+	// revisions are sorted in ascending version order
+	revisions []*revision
+	log       *zap.Logger
+}
+
+var _ Translation = (*translator)(nil)
+
+// newTranslatorFromReader parses the schema file content and returns
+// a translation that converts signals to the version of targetSchemaURL.
+func newTranslatorFromReader(log *zap.Logger, targetSchemaURL string, content io.Reader) (*translator, error) {
+	_, target, err := GetFamilyAndVersion(targetSchemaURL)
+	if err != nil {
+		return nil, err
+	}
+	def, err := schema.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &translator{
+		targetSchemaURL: targetSchemaURL,
+		target:          target,
+		log:             log,
+	}
+	for v, changes := range def.Versions {
+		ver, err := NewVersion(string(v))
+		if err != nil {
+			return nil, fmt.Errorf("schema %q: %w", def.SchemaURL, err)
+		}
+		t.revisions = append(t.revisions, newRevision(ver, changes))
+	}
+	sort.Slice(t.revisions, func(i, j int) bool {
+		return t.revisions[i].ver.LessThan(t.revisions[j].ver)
+	})
+	if !t.SupportedVersion(target) {
+		return nil, fmt.Errorf("schema %q does not define target version %s: %w", def.SchemaURL, target, ErrInvalidVersion)
+	}
+	return t, nil
+}
+
+func (t *translator) SupportedVersion(v *Version) bool {
+	if len(t.revisions) == 0 {
+		return false
+	}
+	return !v.LessThan(t.revisions[0].ver) && !v.GreaterThan(t.revisions[len(t.revisions)-1].ver)
+}
+
+// revisionsFrom returns the revisions to apply in order to convert
+// signals of the version from into the target version, and whether
+// these revisions upgrade or downgrade the signals.
+func (t *translator) revisionsFrom(inSchemaURL string) (revisions []*revision, upgrade bool) {
+	_, from, err := GetFamilyAndVersion(inSchemaURL)
+	if err != nil {
+		t.log.Debug("Unable to parse schema url", zap.String("schema-url", inSchemaURL), zap.Error(err))
+		return nil, false
+	}
+	if !t.SupportedVersion(from) {
+		t.log.Debug("Unsupported schema version", zap.String("schema-url", inSchemaURL))
+		return nil, false
+	}
+	switch from.Compare(t.target) {
+	case -1:
+		for _, r := range t.revisions {
+			if r.ver.GreaterThan(from) && !r.ver.GreaterThan(t.target) {
+				revisions = append(revisions, r)
+			}
+		}
+		return revisions, true
+	case 1:
+		for i := len(t.revisions) - 1; i >= 0; i-- {
+			if r := t.revisions[i]; r.ver.GreaterThan(t.target) && !r.ver.GreaterThan(from) {
+				revisions = append(revisions, r)
+			}
+		}
+		return revisions, false
+	}
+	return nil, false
+}
+
+func (t *translator) ApplyAllResourceChanges(in alias.Resource, inSchemaURL string) {
+	revisions, upgrade := t.revisionsFrom(inSchemaURL)
+	if len(revisions) == 0 {
+		return
+	}
+	for _, r := range revisions {
+		r.applyResource(in.Resource(), upgrade)
+	}
+	in.SetSchemaUrl(t.targetSchemaURL)
+}
+
+func (t *translator) ApplyScopeSpanChanges(in ptrace.ScopeSpans, inSchemaURL string) {
+	revisions, upgrade := t.revisionsFrom(inSchemaURL)
+	if len(revisions) == 0 {
+		return
+	}
+	for i := 0; i < in.Spans().Len(); i++ {
+		span := in.Spans().At(i)
+		for _, r := range revisions {
+			r.applySpan(span, upgrade)
+		}
+	}
+	if in.SchemaUrl() != "" {
+		in.SetSchemaUrl(t.targetSchemaURL)
+	}
+}
+
+func (t *translator) ApplyScopeLogChanges(in plog.ScopeLogs, inSchemaURL string) {
+	revisions, upgrade := t.revisionsFrom(inSchemaURL)
+	if len(revisions) == 0 {
+		return
+	}
+	for i := 0; i < in.LogRecords().Len(); i++ {
+		log := in.LogRecords().At(i)
+		for _, r := range revisions {
+			r.applyLog(log, upgrade)
+		}
+	}
+	if in.SchemaUrl() != "" {
+		in.SetSchemaUrl(t.targetSchemaURL)
+	}
+}
+
+func (t *translator) ApplyScopeMetricChanges(in pmetric.ScopeMetrics, inSchemaURL string) {
+	revisions, upgrade := t.revisionsFrom(inSchemaURL)
+	if len(revisions) == 0 {
+		return
+	}
+	for i := 0; i < in.Metrics().Len(); i++ {
+		metric := in.Metrics().At(i)
+		for _, r := range revisions {
+			r.applyMetric(metric, upgrade)
+		}
+	}
+	if in.SchemaUrl() != "" {
+		in.SetSchemaUrl(t.targetSchemaURL)
+	}
+}
+
+// nopTranslation is used when the schema family is not
+// one of the targets or its schema file could not be retrieved,
+// in which case the signals are passed through unchanged.
+type nopTranslation struct{}
+
+var _ Translation = (*nopTranslation)(nil)
+
+func (nopTranslation) SupportedVersion(_ *Version) bool { return false }
+
+func (nopTranslation) ApplyAllResourceChanges(_ alias.Resource, _ string) {}
+
+func (nopTranslation) ApplyScopeSpanChanges(_ ptrace.ScopeSpans, _ string) {}
+
+func (nopTranslation) ApplyScopeLogChanges(_ plog.ScopeLogs, _ string) {}
+
+func (nopTranslation) ApplyScopeMetricChanges(_ pmetric.ScopeMetrics, _ string) {}
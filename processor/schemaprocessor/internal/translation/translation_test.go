@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap/zaptest"
+)
+
+const (
+	olderSchemaURL = "https://example.com/schemas/1.0.0"
+	newerSchemaURL = "https://example.com/schemas/1.1.0"
+)
+
+func loadSchemaContent(tb testing.TB) []byte {
+	content, err := os.ReadFile(filepath.Join("..", "..", "testdata", "schema.yml"))
+	require.NoError(tb, err, "Must be able to read the schema file")
+	return content
+}
+
+func newTestTranslator(tb testing.TB, target string) *translator {
+	tn, err := newTranslatorFromReader(zaptest.NewLogger(tb), target, bytes.NewReader(loadSchemaContent(tb)))
+	require.NoError(tb, err, "Must not error when creating translator")
+	return tn
+}
+
+func TestTranslatorInvalidTarget(t *testing.T) {
+	t.Parallel()
+
+	_, err := newTranslatorFromReader(zaptest.NewLogger(t), "https://example.com/schemas/1.2.0", bytes.NewReader(loadSchemaContent(t)))
+	assert.ErrorIs(t, err, ErrInvalidVersion, "Must error when the target is not defined")
+
+	_, err = newTranslatorFromReader(zaptest.NewLogger(t), newerSchemaURL, bytes.NewBufferString("file_format: 2.0.0"))
+	assert.Error(t, err, "Must error with an invalid schema file")
+}
+
+func TestTranslatorSupportedVersion(t *testing.T) {
+	t.Parallel()
+
+	tn := newTestTranslator(t, newerSchemaURL)
+	assert.True(t, tn.SupportedVersion(&Version{1, 0, 0}))
+	assert.True(t, tn.SupportedVersion(&Version{1, 1, 0}))
+	assert.False(t, tn.SupportedVersion(&Version{1, 2, 0}))
+	assert.False(t, tn.SupportedVersion(&Version{0, 9, 0}))
+}
+
+func TestTranslatorResource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		scenario string
+		from, to string
+		in, out  map[string]any
+	}{
+		{
+			scenario: "upgrade",
+			from:     olderSchemaURL,
+			to:       newerSchemaURL,
+			in:       map[string]any{"k8s.pod.name": "pod", "telemetry.auto.version": "1.0", "service.name": "svc"},
+			out:      map[string]any{"kubernetes.pod.name": "pod", "telemetry.auto_instr.version": "1.0", "service.name": "svc"},
+		},
+		{
+			scenario: "downgrade",
+			from:     newerSchemaURL,
+			to:       olderSchemaURL,
+			in:       map[string]any{"kubernetes.pod.name": "pod", "telemetry.auto_instr.version": "1.0", "service.name": "svc"},
+			out:      map[string]any{"k8s.pod.name": "pod", "telemetry.auto.version": "1.0", "service.name": "svc"},
+		},
+		{
+			scenario: "same version",
+			from:     newerSchemaURL,
+			to:       newerSchemaURL,
+			in:       map[string]any{"k8s.pod.name": "pod"},
+			out:      map[string]any{"k8s.pod.name": "pod"},
+		},
+		{
+			scenario: "conflicting attribute",
+			from:     olderSchemaURL,
+			to:       newerSchemaURL,
+			in:       map[string]any{"k8s.pod.name": "old", "kubernetes.pod.name": "new"},
+			out:      map[string]any{"k8s.pod.name": "old", "kubernetes.pod.name": "new"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scenario, func(t *testing.T) {
+			tn := newTestTranslator(t, tc.to)
+
+			rl := plog.NewResourceLogs()
+			rl.SetSchemaUrl(tc.from)
+			require.NoError(t, rl.Resource().Attributes().FromRaw(tc.in))
+
+			tn.ApplyAllResourceChanges(rl, tc.from)
+			assert.Equal(t, tc.out, rl.Resource().Attributes().AsRaw())
+			assert.Equal(t, tc.to, rl.SchemaUrl(), "Must update the schema url")
+		})
+	}
+}
+
+func TestTranslatorSpans(t *testing.T) {
+	t.Parallel()
+
+	ss := ptrace.NewScopeSpans()
+	span := ss.Spans().AppendEmpty()
+	span.SetName("HTTP GET")
+	span.Attributes().PutStr("peer.service", "db")
+	span.Attributes().PutStr("k8s.pod.name", "pod")
+	event := span.Events().AppendEmpty()
+	event.SetName("stacktrace")
+	event.Attributes().PutStr("peer.service", "db")
+
+	newTestTranslator(t, newerSchemaURL).ApplyScopeSpanChanges(ss, olderSchemaURL)
+	assert.Equal(t, map[string]any{"peer.service.name": "db", "kubernetes.pod.name": "pod"}, span.Attributes().AsRaw())
+	assert.Equal(t, "stack_trace", event.Name(), "Must rename the span event")
+	assert.Equal(t, map[string]any{"peer.service": "db"}, event.Attributes().AsRaw(), "Must only rename attributes of the listed events")
+
+	newTestTranslator(t, olderSchemaURL).ApplyScopeSpanChanges(ss, newerSchemaURL)
+	assert.Equal(t, map[string]any{"peer.service": "db", "k8s.pod.name": "pod"}, span.Attributes().AsRaw())
+	assert.Equal(t, "stacktrace", event.Name(), "Must rename the span event")
+	assert.Equal(t, map[string]any{"peer.service": "db"}, event.Attributes().AsRaw())
+
+	// Span attributes are only renamed for the spans listed in the schema.
+	span.SetName("HTTP POST")
+	newTestTranslator(t, newerSchemaURL).ApplyScopeSpanChanges(ss, olderSchemaURL)
+	assert.Equal(t, map[string]any{"peer.service": "db", "kubernetes.pod.name": "pod"}, span.Attributes().AsRaw())
+}
+
+func TestTranslatorSpanEventAttributes(t *testing.T) {
+	t.Parallel()
+
+	ss := ptrace.NewScopeSpans()
+	ss.SetSchemaUrl(olderSchemaURL)
+	event := ss.Spans().AppendEmpty().Events().AppendEmpty()
+	event.SetName("exception.stack_trace")
+	event.Attributes().PutStr("peer.service", "db")
+
+	newTestTranslator(t, newerSchemaURL).ApplyScopeSpanChanges(ss, olderSchemaURL)
+	assert.Equal(t, map[string]any{"peer.service.name": "db"}, event.Attributes().AsRaw())
+	assert.Equal(t, newerSchemaURL, ss.SchemaUrl(), "Must update the scope schema url")
+
+	newTestTranslator(t, olderSchemaURL).ApplyScopeSpanChanges(ss, newerSchemaURL)
+	assert.Equal(t, map[string]any{"peer.service": "db"}, event.Attributes().AsRaw())
+	assert.Equal(t, olderSchemaURL, ss.SchemaUrl(), "Must update the scope schema url")
+}
+
+func TestTranslatorMetrics(t *testing.T) {
+	t.Parallel()
+
+	sm := pmetric.NewScopeMetrics()
+	renamed := sm.Metrics().AppendEmpty()
+	renamed.SetName("container.cpu.usage.total")
+	renamedAttrs := renamed.SetEmptySum().DataPoints().AppendEmpty().Attributes()
+	renamedAttrs.PutStr("status", "idle")
+	renamedAttrs.PutStr("k8s.pod.name", "pod")
+	utilization := sm.Metrics().AppendEmpty()
+	utilization.SetName("system.cpu.utilization")
+	utilizationAttrs := utilization.SetEmptyGauge().DataPoints().AppendEmpty().Attributes()
+	utilizationAttrs.PutStr("status", "idle")
+	utilizationAttrs.PutStr("k8s.pod.name", "pod")
+
+	newTestTranslator(t, newerSchemaURL).ApplyScopeMetricChanges(sm, olderSchemaURL)
+	assert.Equal(t, "cpu.usage.total", renamed.Name(), "Must rename the metric")
+	assert.Equal(t, map[string]any{"status": "idle", "kubernetes.pod.name": "pod"}, renamedAttrs.AsRaw())
+	assert.Equal(t, "system.cpu.utilization", utilization.Name())
+	assert.Equal(t, map[string]any{"state": "idle", "kubernetes.pod.name": "pod"}, utilizationAttrs.AsRaw())
+
+	newTestTranslator(t, olderSchemaURL).ApplyScopeMetricChanges(sm, newerSchemaURL)
+	assert.Equal(t, "container.cpu.usage.total", renamed.Name(), "Must rename the metric")
+	assert.Equal(t, map[string]any{"status": "idle", "k8s.pod.name": "pod"}, renamedAttrs.AsRaw())
+	assert.Equal(t, "system.cpu.utilization", utilization.Name())
+	assert.Equal(t, map[string]any{"status": "idle", "k8s.pod.name": "pod"}, utilizationAttrs.AsRaw())
+}
+
+func TestTranslatorLogs(t *testing.T) {
+	t.Parallel()
+
+	sl := plog.NewScopeLogs()
+	attrs := sl.LogRecords().AppendEmpty().Attributes()
+	attrs.PutStr("process.executable_name", "otelcol")
+	attrs.PutStr("k8s.node.name", "node")
+
+	newTestTranslator(t, newerSchemaURL).ApplyScopeLogChanges(sl, olderSchemaURL)
+	assert.Equal(t, map[string]any{"process.executable.name": "otelcol", "kubernetes.node.name": "node"}, attrs.AsRaw())
+	assert.Empty(t, sl.SchemaUrl(), "Must not set a scope schema url")
+
+	newTestTranslator(t, olderSchemaURL).ApplyScopeLogChanges(sl, newerSchemaURL)
+	assert.Equal(t, map[string]any{"process.executable_name": "otelcol", "k8s.node.name": "node"}, attrs.AsRaw())
+}
+
+func TestTranslatorUnsupportedVersion(t *testing.T) {
+	t.Parallel()
+
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("k8s.pod.name", "pod")
+	rm := pmetric.NewResourceMetrics()
+	res.CopyTo(rm.Resource())
+	rm.SetSchemaUrl("https://example.com/schemas/1.3.0")
+
+	newTestTranslator(t, newerSchemaURL).ApplyAllResourceChanges(rm, rm.SchemaUrl())
+	assert.Equal(t, res, rm.Resource(), "Must not modify signals of an unknown version")
+	assert.Equal(t, "https://example.com/schemas/1.3.0", rm.SchemaUrl())
+}
@@ -8,16 +8,25 @@ import (
 	"errors"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
 )
 
 type transformer struct {
-	targets []string
-	log     *zap.Logger
+	targets        []string
+	prefetch       []string
+	cacheDirectory string
+	httpSettings   confighttp.HTTPClientSettings
+	settings       component.TelemetrySettings
+	log            *zap.Logger
+
+	manager translation.Manager
 }
 
 func newTransformer(
@@ -29,29 +38,99 @@ func newTransformer(
 	if !ok {
 		return nil, errors.New("invalid configuration provided")
 	}
+	m, err := translation.NewManager(cfg.Targets, set.Logger.Named("schema-manager"))
+	if err != nil {
+		return nil, err
+	}
 	return &transformer{
-		log:     set.Logger,
-		targets: cfg.Targets,
+		log:            set.Logger,
+		settings:       set.TelemetrySettings,
+		targets:        cfg.Targets,
+		prefetch:       cfg.Prefetch,
+		cacheDirectory: cfg.CacheDirectory,
+		httpSettings:   cfg.HTTPClientSettings,
+		manager:        m,
 	}, nil
 }
 
 func (t transformer) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	for rl := 0; rl < ld.ResourceLogs().Len(); rl++ {
+		rLogs := ld.ResourceLogs().At(rl)
+		resourceSchemaURL := rLogs.SchemaUrl()
+		t.manager.
+			RequestTranslation(ctx, resourceSchemaURL).
+			ApplyAllResourceChanges(rLogs, resourceSchemaURL)
+		for sl := 0; sl < rLogs.ScopeLogs().Len(); sl++ {
+			logs := rLogs.ScopeLogs().At(sl)
+			logsSchemaURL := logs.SchemaUrl()
+			if logsSchemaURL == "" {
+				logsSchemaURL = resourceSchemaURL
+			}
+			t.manager.
+				RequestTranslation(ctx, logsSchemaURL).
+				ApplyScopeLogChanges(logs, logsSchemaURL)
+		}
+	}
 	return ld, nil
 }
 
 func (t transformer) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	for rm := 0; rm < md.ResourceMetrics().Len(); rm++ {
+		rMetrics := md.ResourceMetrics().At(rm)
+		resourceSchemaURL := rMetrics.SchemaUrl()
+		t.manager.
+			RequestTranslation(ctx, resourceSchemaURL).
+			ApplyAllResourceChanges(rMetrics, resourceSchemaURL)
+		for sm := 0; sm < rMetrics.ScopeMetrics().Len(); sm++ {
+			metrics := rMetrics.ScopeMetrics().At(sm)
+			metricsSchemaURL := metrics.SchemaUrl()
+			if metricsSchemaURL == "" {
+				metricsSchemaURL = resourceSchemaURL
+			}
+			t.manager.
+				RequestTranslation(ctx, metricsSchemaURL).
+				ApplyScopeMetricChanges(metrics, metricsSchemaURL)
+		}
+	}
 	return md, nil
 }
 
 func (t transformer) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	for rt := 0; rt < td.ResourceSpans().Len(); rt++ {
+		rSpans := td.ResourceSpans().At(rt)
+		resourceSchemaURL := rSpans.SchemaUrl()
+		t.manager.
+			RequestTranslation(ctx, resourceSchemaURL).
+			ApplyAllResourceChanges(rSpans, resourceSchemaURL)
+		for ss := 0; ss < rSpans.ScopeSpans().Len(); ss++ {
+			spans := rSpans.ScopeSpans().At(ss)
+			spansSchemaURL := spans.SchemaUrl()
+			if spansSchemaURL == "" {
+				spansSchemaURL = resourceSchemaURL
+			}
+			t.manager.
+				RequestTranslation(ctx, spansSchemaURL).
+				ApplyScopeSpanChanges(spans, spansSchemaURL)
+		}
+	}
 	return td, nil
 }
 
 // start will load the remote file definition if it isn't already cached
 // and resolve the schema translation file
 func (t *transformer) start(ctx context.Context, host component.Host) error {
-	for _, target := range t.targets {
-		t.log.Info("Fetching remote schema url", zap.String("schema-url", target))
+	client, err := t.httpSettings.ToClient(host, t.settings)
+	if err != nil {
+		return err
+	}
+	t.manager.SetProvider(translation.NewCacheProvider(
+		t.cacheDirectory,
+		translation.NewHTTPProvider(client),
+	))
+
+	for _, schemaURL := range t.prefetch {
+		t.log.Info("Fetching remote schema url", zap.String("schema-url", schemaURL))
+		t.manager.Prefetch(ctx, schemaURL)
 	}
 	return nil
 }
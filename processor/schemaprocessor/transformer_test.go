@@ -7,11 +7,13 @@ import (
 	"context"
 	_ "embed"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -98,3 +100,82 @@ func TestTransformerProcessing(t *testing.T) {
 		assert.Equal(t, in, out, "Must return the same data (subject to change)")
 	})
 }
+
+func TestTransformerTranslation(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(SchemaHandler(t)))
+	t.Cleanup(s.Close)
+
+	var (
+		olderSchemaURL = s.URL + "/schemas/1.0.0"
+		newerSchemaURL = s.URL + "/schemas/1.1.0"
+	)
+
+	newStartedTransformer := func(t *testing.T, target string) *transformer {
+		cfg := newDefaultConfiguration().(*Config)
+		cfg.Targets = []string{target}
+		cfg.Prefetch = []string{olderSchemaURL, newerSchemaURL}
+		cfg.CacheDirectory = t.TempDir()
+		trans, err := newTransformer(context.Background(), cfg, processor.CreateSettings{
+			TelemetrySettings: component.TelemetrySettings{
+				Logger: zaptest.NewLogger(t),
+			},
+		})
+		require.NoError(t, err, "Must not error when creating transformer")
+		require.NoError(t, trans.start(context.Background(), componenttest.NewNopHost()))
+		return trans
+	}
+
+	t.Run("metrics", func(t *testing.T) {
+		in := pmetric.NewMetrics()
+		rm := in.ResourceMetrics().AppendEmpty()
+		rm.SetSchemaUrl(olderSchemaURL)
+		rm.Resource().Attributes().PutStr("k8s.pod.name", "pod")
+		m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("container.memory.usage.max")
+		m.SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("k8s.container.name", "collector")
+
+		out, err := newStartedTransformer(t, newerSchemaURL).processMetrics(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing metrics")
+		rm = out.ResourceMetrics().At(0)
+		assert.Equal(t, newerSchemaURL, rm.SchemaUrl())
+		assert.Equal(t, map[string]any{"kubernetes.pod.name": "pod"}, rm.Resource().Attributes().AsRaw())
+		m = rm.ScopeMetrics().At(0).Metrics().At(0)
+		assert.Equal(t, "memory.usage.max", m.Name())
+		assert.Equal(t, map[string]any{"kubernetes.container.name": "collector"}, m.Gauge().DataPoints().At(0).Attributes().AsRaw())
+	})
+
+	t.Run("traces", func(t *testing.T) {
+		in := ptrace.NewTraces()
+		rs := in.ResourceSpans().AppendEmpty()
+		rs.SetSchemaUrl(newerSchemaURL)
+		rs.Resource().Attributes().PutStr("telemetry.auto_instr.version", "1.0")
+		ss := rs.ScopeSpans().AppendEmpty()
+		ss.SetSchemaUrl(olderSchemaURL)
+		span := ss.Spans().AppendEmpty()
+		span.SetName("HTTP GET")
+		span.Attributes().PutStr("peer.service", "db")
+
+		out, err := newStartedTransformer(t, olderSchemaURL).processTraces(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing traces")
+		rs = out.ResourceSpans().At(0)
+		assert.Equal(t, olderSchemaURL, rs.SchemaUrl())
+		assert.Equal(t, map[string]any{"telemetry.auto.version": "1.0"}, rs.Resource().Attributes().AsRaw())
+		assert.Equal(t, olderSchemaURL, rs.ScopeSpans().At(0).SchemaUrl())
+		assert.Equal(t, map[string]any{"peer.service": "db"}, rs.ScopeSpans().At(0).Spans().At(0).Attributes().AsRaw(), "Must not translate a scope already at the target")
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		in := plog.NewLogs()
+		rl := in.ResourceLogs().AppendEmpty()
+		rl.SetSchemaUrl(newerSchemaURL)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes().PutStr("process.executable.name", "otelcol")
+
+		out, err := newStartedTransformer(t, olderSchemaURL).processLogs(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing logs")
+		rl = out.ResourceLogs().At(0)
+		assert.Equal(t, olderSchemaURL, rl.SchemaUrl())
+		assert.Equal(t, map[string]any{"process.executable_name": "otelcol"}, rl.ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw())
+	})
+}
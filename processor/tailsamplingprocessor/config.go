@@ -33,6 +33,9 @@ const (
 	Composite PolicyType = "composite"
 	// And allows defining a And policy, combining the other policies in one
 	And PolicyType = "and"
+	// Drop allows defining a Drop policy, combining the other policies in one. A trace matching
+	// all of them is dropped, regardless of the decisions of every other policy.
+	Drop PolicyType = "drop"
 	// SpanCount sample traces that are have more spans per Trace than a given threshold.
 	SpanCount PolicyType = "span_count"
 	// TraceState sample traces with specified values by the given key
@@ -98,6 +101,11 @@ type AndCfg struct {
 	SubPolicyCfg []AndSubPolicyCfg `mapstructure:"and_sub_policy"`
 }
 
+// DropCfg holds the configurable settings to create a drop sampling policy evaluator.
+type DropCfg struct {
+	SubPolicyCfg []AndSubPolicyCfg `mapstructure:"drop_sub_policy"`
+}
+
 // CompositeCfg holds the configurable settings to create a composite
 // sampling policy evaluator.
 type CompositeCfg struct {
@@ -121,6 +129,8 @@ type PolicyCfg struct {
 	CompositeCfg CompositeCfg `mapstructure:"composite"`
 	// Configs for defining and policy
 	AndCfg AndCfg `mapstructure:"and"`
+	// Configs for defining drop policy
+	DropCfg DropCfg `mapstructure:"drop"`
 }
 
 // LatencyCfg holds the configurable settings to create a latency filter sampling policy
@@ -226,4 +236,17 @@ type Config struct {
 	// PolicyCfgs sets the tail-based sampling policy which makes a sampling decision
 	// for a given trace when requested.
 	PolicyCfgs []PolicyCfg `mapstructure:"policies"`
+	// DecisionCache holds the settings of the caches of sampling decisions, used to apply
+	// the decision of a trace to its spans arriving after it has been released from memory.
+	DecisionCache DecisionCacheConfig `mapstructure:"decision_cache"`
+}
+
+// DecisionCacheConfig holds the sizes of the caches of sampling decisions.
+type DecisionCacheConfig struct {
+	// SampledCacheSize is the maximum number of trace IDs kept in the cache of sampled traces.
+	// The cache is disabled when zero.
+	SampledCacheSize int `mapstructure:"sampled_cache_size"`
+	// NonSampledCacheSize is the maximum number of trace IDs kept in the cache of not sampled traces.
+	// The cache is disabled when zero.
+	NonSampledCacheSize int `mapstructure:"non_sampled_cache_size"`
 }
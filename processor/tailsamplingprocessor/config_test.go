@@ -35,6 +35,7 @@ func TestLoadConfig(t *testing.T) {
 			DecisionWait:            10 * time.Second,
 			NumTraces:               100,
 			ExpectedNewTracesPerSec: 10,
+			DecisionCache:           DecisionCacheConfig{SampledCacheSize: 500, NonSampledCacheSize: 1000},
 			PolicyCfgs: []PolicyCfg{
 				{
 					sharedPolicyCfg: sharedPolicyCfg{
@@ -140,6 +141,23 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name: "drop-policy-1",
+						Type: Drop,
+					},
+					DropCfg: DropCfg{
+						SubPolicyCfg: []AndSubPolicyCfg{
+							{
+								sharedPolicyCfg: sharedPolicyCfg{
+									Name:               "test-drop-policy-1",
+									Type:               StringAttribute,
+									StringAttributeCfg: StringAttributeCfg{Key: "url.path", Values: []string{"\\/health", "\\/metrics"}, EnabledRegexMatching: true},
+								},
+							},
+						},
+					},
+				},
 				{
 					sharedPolicyCfg: sharedPolicyCfg{
 						Name: "composite-policy-1",
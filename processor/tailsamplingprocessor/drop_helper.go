@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+// errNoDropSubPolicies is returned for a drop policy without sub-policies,
+// which would otherwise match, and drop, every trace.
+var errNoDropSubPolicies = errors.New("drop policy must have at least one drop_sub_policy")
+
+func getNewDropPolicy(settings component.TelemetrySettings, config *DropCfg) (sampling.PolicyEvaluator, error) {
+	if len(config.SubPolicyCfg) == 0 {
+		return nil, errNoDropSubPolicies
+	}
+	var subPolicyEvaluators []sampling.PolicyEvaluator
+	for i := range config.SubPolicyCfg {
+		policyCfg := &config.SubPolicyCfg[i]
+		policy, err := getAndSubPolicyEvaluator(settings, policyCfg)
+		if err != nil {
+			return nil, err
+		}
+		subPolicyEvaluators = append(subPolicyEvaluators, policy)
+	}
+	return sampling.NewDrop(settings.Logger, subPolicyEvaluators), nil
+}
@@ -0,0 +1,16 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cache provides caches of trace IDs, used to remember the sampling
+// decisions of traces after they have been released from memory.
+package cache // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/cache"
+
+import "go.opentelemetry.io/collector/pdata/pcommon"
+
+// Cache is a set of trace IDs. Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get reports whether the trace ID is in the cache.
+	Get(id pcommon.TraceID) bool
+	// Put adds the trace ID to the cache.
+	Put(id pcommon.TraceID)
+}
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cache // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/cache"
+
+import (
+	"sync"
+
+	"github.com/golang/groupcache/lru"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// lruCache is a Cache of a fixed size that evicts the least recently used trace IDs.
+type lruCache struct {
+	mu    sync.Mutex
+	cache *lru.Cache
+}
+
+var _ Cache = (*lruCache)(nil)
+
+// NewLRUCache returns a Cache holding up to size trace IDs. onEvicted, if not nil, is called
+// with each trace ID evicted to make room for a new one.
+func NewLRUCache(size int, onEvicted func(id pcommon.TraceID)) Cache {
+	cache := lru.New(size)
+	if onEvicted != nil {
+		cache.OnEvicted = func(key lru.Key, _ interface{}) {
+			onEvicted(key.(pcommon.TraceID))
+		}
+	}
+	return &lruCache{cache: cache}
+}
+
+func (c *lruCache) Get(id pcommon.TraceID) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.cache.Get(id)
+	return ok
+}
+
+func (c *lruCache) Put(id pcommon.TraceID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Add(id, struct{}{})
+}
@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestLRUCache(t *testing.T) {
+	id1 := pcommon.TraceID([16]byte{1})
+	id2 := pcommon.TraceID([16]byte{2})
+	id3 := pcommon.TraceID([16]byte{3})
+
+	var evicted []pcommon.TraceID
+	c := NewLRUCache(2, func(id pcommon.TraceID) {
+		evicted = append(evicted, id)
+	})
+
+	c.Put(id1)
+	c.Put(id2)
+	assert.True(t, c.Get(id1))
+	assert.True(t, c.Get(id2))
+
+	// id1 is the least recently used trace ID.
+	c.Put(id3)
+	assert.False(t, c.Get(id1))
+	assert.True(t, c.Get(id2))
+	assert.True(t, c.Get(id3))
+	assert.Equal(t, []pcommon.TraceID{id1}, evicted)
+}
+
+func TestNopCache(t *testing.T) {
+	id := pcommon.TraceID([16]byte{1})
+
+	c := NewNopCache()
+	c.Put(id)
+	assert.False(t, c.Get(id))
+}
@@ -0,0 +1,20 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cache // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/cache"
+
+import "go.opentelemetry.io/collector/pdata/pcommon"
+
+// nopCache is a Cache that never holds any trace ID.
+type nopCache struct{}
+
+var _ Cache = (*nopCache)(nil)
+
+// NewNopCache returns a Cache that never holds any trace ID, used when caching is disabled.
+func NewNopCache() Cache {
+	return nopCache{}
+}
+
+func (nopCache) Get(pcommon.TraceID) bool { return false }
+
+func (nopCache) Put(pcommon.TraceID) {}
@@ -44,8 +44,8 @@ const (
 	// NotSampled is used to indicate that the decision was already taken
 	// to not sample the data.
 	NotSampled
-	// Dropped is used when data needs to be purged before the sampling policy
-	// had a chance to evaluate it.
+	// Dropped is used to indicate that the data must not be sampled, regardless
+	// of the decisions of the other policies.
 	Dropped
 	// Error is used to indicate that policy evaluation was not succeeded.
 	Error
@@ -30,6 +30,9 @@ var (
 	statDroppedTooEarlyCount    = stats.Int64("sampling_trace_dropped_too_early", "Count of traces that needed to be dropped the configured wait time", stats.UnitDimensionless)
 	statNewTraceIDReceivedCount = stats.Int64("new_trace_id_received", "Counts the arrival of new traces", stats.UnitDimensionless)
 	statTracesOnMemoryGauge     = stats.Int64("sampling_traces_on_memory", "Tracks the number of traces current on memory", stats.UnitDimensionless)
+
+	statDecisionCacheHitCount      = stats.Int64("sampling_decision_cache_hit", "Count of spans whose sampling decision was found in the decision cache", stats.UnitDimensionless)
+	statDecisionCacheEvictionCount = stats.Int64("sampling_decision_cache_eviction", "Count of trace IDs evicted from the decision cache", stats.UnitDimensionless)
 )
 
 // SamplingProcessorMetricViews return the metrics views according to given telemetry level.
@@ -105,6 +108,22 @@ func SamplingProcessorMetricViews(level configtelemetry.Level) []*view.View {
 		Aggregation: view.LastValue(),
 	}
 
+	decisionCacheTagKeys := []tag.Key{tagSampledKey}
+	countDecisionCacheHitView := &view.View{
+		Name:        obsreport.BuildProcessorCustomMetricName(typeStr, statDecisionCacheHitCount.Name()),
+		Measure:     statDecisionCacheHitCount,
+		Description: statDecisionCacheHitCount.Description(),
+		TagKeys:     decisionCacheTagKeys,
+		Aggregation: view.Sum(),
+	}
+	countDecisionCacheEvictionView := &view.View{
+		Name:        obsreport.BuildProcessorCustomMetricName(typeStr, statDecisionCacheEvictionCount.Name()),
+		Measure:     statDecisionCacheEvictionCount,
+		Description: statDecisionCacheEvictionCount.Description(),
+		TagKeys:     decisionCacheTagKeys,
+		Aggregation: view.Sum(),
+	}
+
 	return []*view.View{
 		decisionLatencyView,
 		overallDecisionLatencyView,
@@ -119,5 +138,8 @@ func SamplingProcessorMetricViews(level configtelemetry.Level) []*view.View {
 		countTraceDroppedTooEarlyView,
 		countTraceIDArrivalView,
 		trackTracesOnMemorylView,
+
+		countDecisionCacheHitView,
+		countDecisionCacheEvictionView,
 	}
 }
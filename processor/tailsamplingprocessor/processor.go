@@ -21,6 +21,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/timeutils"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/cache"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/idbatcher"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
 )
@@ -50,6 +51,11 @@ type tailSamplingSpanProcessor struct {
 	decisionBatcher idbatcher.Batcher
 	deleteChan      chan pcommon.TraceID
 	numTracesOnMap  *atomic.Uint64
+
+	// sampledIDCache and nonSampledIDCache keep the decisions of traces released
+	// from idToTrace, so that their late spans follow the original decision.
+	sampledIDCache    cache.Cache
+	nonSampledIDCache cache.Cache
 }
 
 const (
@@ -99,18 +105,38 @@ func newTracesProcessor(ctx context.Context, settings component.TelemetrySetting
 		numTracesOnMap:  &atomic.Uint64{},
 	}
 
+	tsp.sampledIDCache = newDecisionCache(ctx, cfg.DecisionCache.SampledCacheSize, "true")
+	tsp.nonSampledIDCache = newDecisionCache(ctx, cfg.DecisionCache.NonSampledCacheSize, "false")
+
 	tsp.policyTicker = &timeutils.PolicyTicker{OnTickFunc: tsp.samplingPolicyOnTick}
 	tsp.deleteChan = make(chan pcommon.TraceID, cfg.NumTraces)
 
 	return tsp, nil
 }
 
+// newDecisionCache returns a cache of the trace IDs with the given decision, or a no-op
+// cache if size is not positive. sampled is the value of the sampled tag of its metrics.
+func newDecisionCache(ctx context.Context, size int, sampled string) cache.Cache {
+	if size <= 0 {
+		return cache.NewNopCache()
+	}
+	return cache.NewLRUCache(size, func(pcommon.TraceID) {
+		_ = stats.RecordWithTags(
+			ctx,
+			[]tag.Mutator{tag.Upsert(tagSampledKey, sampled)},
+			statDecisionCacheEvictionCount.M(int64(1)),
+		)
+	})
+}
+
 func getPolicyEvaluator(settings component.TelemetrySettings, cfg *PolicyCfg) (sampling.PolicyEvaluator, error) {
 	switch cfg.Type {
 	case Composite:
 		return getNewCompositePolicy(settings, &cfg.CompositeCfg)
 	case And:
 		return getNewAndPolicy(settings, &cfg.AndCfg)
+	case Drop:
+		return getNewDropPolicy(settings, &cfg.DropCfg)
 	default:
 		return getSharedPolicyEvaluator(settings, &cfg.sharedPolicyCfg)
 	}
@@ -186,7 +212,10 @@ func (tsp *tailSamplingSpanProcessor) samplingPolicyOnTick() {
 		trace.Unlock()
 
 		if decision == sampling.Sampled {
+			tsp.sampledIDCache.Put(id)
 			_ = tsp.nextConsumer.ConsumeTraces(policy.ctx, allSpans)
+		} else {
+			tsp.nonSampledIDCache.Put(id)
 		}
 	}
 
@@ -214,6 +243,7 @@ func (tsp *tailSamplingSpanProcessor) makeDecision(id pcommon.TraceID, trace *sa
 		sampling.NotSampled:       false,
 		sampling.InvertSampled:    false,
 		sampling.InvertNotSampled: false,
+		sampling.Dropped:          false,
 	}
 
 	// Check all policies before making a final decision
@@ -246,12 +276,18 @@ func (tsp *tailSamplingSpanProcessor) makeDecision(id pcommon.TraceID, trace *sa
 			case sampling.InvertNotSampled:
 				samplingDecision[sampling.InvertNotSampled] = true
 				trace.Decisions[i] = sampling.NotSampled
+
+			case sampling.Dropped:
+				samplingDecision[sampling.Dropped] = true
+				trace.Decisions[i] = sampling.NotSampled
 			}
 		}
 	}
 
-	// InvertNotSampled takes precedence over any other decision
+	// Dropped takes precedence over any other decision, followed by InvertNotSampled
 	switch {
+	case samplingDecision[sampling.Dropped]:
+		finalDecision = sampling.NotSampled
 	case samplingDecision[sampling.InvertNotSampled]:
 		finalDecision = sampling.NotSampled
 	case samplingDecision[sampling.Sampled]:
@@ -318,6 +354,10 @@ func (tsp *tailSamplingSpanProcessor) processTraces(resourceSpans ptrace.Resourc
 	idToSpans := tsp.groupSpansByTraceKey(resourceSpans)
 	var newTraceIDs int64
 	for id, spans := range idToSpans {
+		if tsp.applyCachedDecision(id, resourceSpans, spans) {
+			continue
+		}
+
 		lenSpans := int64(len(spans))
 		lenPolicies := len(tsp.policies)
 		initialDecisions := make([]sampling.Decision, lenPolicies)
@@ -388,6 +428,36 @@ func (tsp *tailSamplingSpanProcessor) processTraces(resourceSpans ptrace.Resourc
 	stats.Record(tsp.ctx, statNewTraceIDReceivedCount.M(newTraceIDs))
 }
 
+// applyCachedDecision forwards or drops the spans of a trace whose sampling decision is in
+// the decision caches. It returns false if there is no cached decision for the trace.
+func (tsp *tailSamplingSpanProcessor) applyCachedDecision(id pcommon.TraceID, resourceSpans ptrace.ResourceSpans, spans []*ptrace.Span) bool {
+	switch {
+	case tsp.sampledIDCache.Get(id):
+		_ = stats.RecordWithTags(
+			tsp.ctx,
+			[]tag.Mutator{tag.Upsert(tagSampledKey, "true")},
+			statDecisionCacheHitCount.M(int64(len(spans))),
+		)
+		traceTd := ptrace.NewTraces()
+		appendToTraces(traceTd, resourceSpans, spans)
+		if err := tsp.nextConsumer.ConsumeTraces(tsp.ctx, traceTd); err != nil {
+			tsp.logger.Warn(
+				"Error sending late arrived spans to destination",
+				zap.Error(err))
+		}
+		return true
+	case tsp.nonSampledIDCache.Get(id):
+		_ = stats.RecordWithTags(
+			tsp.ctx,
+			[]tag.Mutator{tag.Upsert(tagSampledKey, "false")},
+			statDecisionCacheHitCount.M(int64(len(spans))),
+		)
+		return true
+	default:
+		return false
+	}
+}
+
 func (tsp *tailSamplingSpanProcessor) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
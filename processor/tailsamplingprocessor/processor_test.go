@@ -21,6 +21,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/timeutils"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/cache"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/idbatcher"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
 )
@@ -178,16 +179,18 @@ func TestSamplingPolicyTypicalPath(t *testing.T) {
 	mpe := &mockPolicyEvaluator{}
 	mtt := &manualTTicker{}
 	tsp := &tailSamplingSpanProcessor{
-		ctx:             context.Background(),
-		nextConsumer:    msp,
-		maxNumTraces:    maxSize,
-		logger:          zap.NewNop(),
-		decisionBatcher: newSyncIDBatcher(decisionWaitSeconds),
-		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
-		deleteChan:      make(chan pcommon.TraceID, maxSize),
-		policyTicker:    mtt,
-		tickerFrequency: 100 * time.Millisecond,
-		numTracesOnMap:  &atomic.Uint64{},
+		ctx:               context.Background(),
+		nextConsumer:      msp,
+		maxNumTraces:      maxSize,
+		logger:            zap.NewNop(),
+		decisionBatcher:   newSyncIDBatcher(decisionWaitSeconds),
+		policies:          []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      mtt,
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    &atomic.Uint64{},
+		sampledIDCache:    cache.NewNopCache(),
+		nonSampledIDCache: cache.NewNopCache(),
 	}
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
@@ -239,16 +242,18 @@ func TestSamplingPolicyInvertSampled(t *testing.T) {
 	mpe := &mockPolicyEvaluator{}
 	mtt := &manualTTicker{}
 	tsp := &tailSamplingSpanProcessor{
-		ctx:             context.Background(),
-		nextConsumer:    msp,
-		maxNumTraces:    maxSize,
-		logger:          zap.NewNop(),
-		decisionBatcher: newSyncIDBatcher(decisionWaitSeconds),
-		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
-		deleteChan:      make(chan pcommon.TraceID, maxSize),
-		policyTicker:    mtt,
-		tickerFrequency: 100 * time.Millisecond,
-		numTracesOnMap:  &atomic.Uint64{},
+		ctx:               context.Background(),
+		nextConsumer:      msp,
+		maxNumTraces:      maxSize,
+		logger:            zap.NewNop(),
+		decisionBatcher:   newSyncIDBatcher(decisionWaitSeconds),
+		policies:          []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      mtt,
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    &atomic.Uint64{},
+		sampledIDCache:    cache.NewNopCache(),
+		nonSampledIDCache: cache.NewNopCache(),
 	}
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
@@ -313,10 +318,12 @@ func TestSamplingMultiplePolicies(t *testing.T) {
 			{
 				name: "policy-2", evaluator: mpe2, ctx: context.TODO(),
 			}},
-		deleteChan:      make(chan pcommon.TraceID, maxSize),
-		policyTicker:    mtt,
-		tickerFrequency: 100 * time.Millisecond,
-		numTracesOnMap:  &atomic.Uint64{},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      mtt,
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    &atomic.Uint64{},
+		sampledIDCache:    cache.NewNopCache(),
+		nonSampledIDCache: cache.NewNopCache(),
 	}
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
@@ -370,16 +377,18 @@ func TestSamplingPolicyDecisionNotSampled(t *testing.T) {
 	mpe := &mockPolicyEvaluator{}
 	mtt := &manualTTicker{}
 	tsp := &tailSamplingSpanProcessor{
-		ctx:             context.Background(),
-		nextConsumer:    msp,
-		maxNumTraces:    maxSize,
-		logger:          zap.NewNop(),
-		decisionBatcher: newSyncIDBatcher(decisionWaitSeconds),
-		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
-		deleteChan:      make(chan pcommon.TraceID, maxSize),
-		policyTicker:    mtt,
-		tickerFrequency: 100 * time.Millisecond,
-		numTracesOnMap:  &atomic.Uint64{},
+		ctx:               context.Background(),
+		nextConsumer:      msp,
+		maxNumTraces:      maxSize,
+		logger:            zap.NewNop(),
+		decisionBatcher:   newSyncIDBatcher(decisionWaitSeconds),
+		policies:          []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      mtt,
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    &atomic.Uint64{},
+		sampledIDCache:    cache.NewNopCache(),
+		nonSampledIDCache: cache.NewNopCache(),
 	}
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
@@ -433,16 +442,18 @@ func TestSamplingPolicyDecisionInvertNotSampled(t *testing.T) {
 	mpe := &mockPolicyEvaluator{}
 	mtt := &manualTTicker{}
 	tsp := &tailSamplingSpanProcessor{
-		ctx:             context.Background(),
-		nextConsumer:    msp,
-		maxNumTraces:    maxSize,
-		logger:          zap.NewNop(),
-		decisionBatcher: newSyncIDBatcher(decisionWaitSeconds),
-		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
-		deleteChan:      make(chan pcommon.TraceID, maxSize),
-		policyTicker:    mtt,
-		tickerFrequency: 100 * time.Millisecond,
-		numTracesOnMap:  &atomic.Uint64{},
+		ctx:               context.Background(),
+		nextConsumer:      msp,
+		maxNumTraces:      maxSize,
+		logger:            zap.NewNop(),
+		decisionBatcher:   newSyncIDBatcher(decisionWaitSeconds),
+		policies:          []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      mtt,
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    &atomic.Uint64{},
+		sampledIDCache:    cache.NewNopCache(),
+		nonSampledIDCache: cache.NewNopCache(),
 	}
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
@@ -487,6 +498,84 @@ func TestSamplingPolicyDecisionInvertNotSampled(t *testing.T) {
 	require.Equal(t, 0, msp.SpanCount())
 }
 
+func TestSamplingPolicyDecisionDropped(t *testing.T) {
+	const maxSize = 100
+	nextConsumer := new(consumertest.TracesSink)
+	sampledPolicy := &mockPolicyEvaluator{NextDecision: sampling.Sampled}
+	dropPolicy := &mockPolicyEvaluator{NextDecision: sampling.Dropped}
+	tsp := &tailSamplingSpanProcessor{
+		ctx:             context.Background(),
+		nextConsumer:    nextConsumer,
+		maxNumTraces:    maxSize,
+		logger:          zap.NewNop(),
+		decisionBatcher: newSyncIDBatcher(1),
+		policies: []*policy{
+			{name: "sampled-policy", evaluator: sampledPolicy, ctx: context.TODO()},
+			{name: "drop-policy", evaluator: dropPolicy, ctx: context.TODO()},
+		},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      &manualTTicker{},
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    &atomic.Uint64{},
+		sampledIDCache:    cache.NewNopCache(),
+		nonSampledIDCache: cache.NewNopCache(),
+	}
+	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, tsp.Shutdown(context.Background()))
+	}()
+
+	traceID := uInt64ToTraceID(1)
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(traceID)))
+	tsp.samplingPolicyOnTick()
+	tsp.samplingPolicyOnTick()
+
+	require.EqualValues(t, 1, sampledPolicy.EvaluationCount)
+	require.EqualValues(t, 1, dropPolicy.EvaluationCount)
+	require.EqualValues(t, 0, nextConsumer.SpanCount(), "dropped trace should not be sampled")
+
+	// Late span of a dropped trace should be ignored
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(traceID)))
+	require.EqualValues(t, 0, nextConsumer.SpanCount())
+}
+
+func TestSamplingPolicyDecisionNotDropped(t *testing.T) {
+	const maxSize = 100
+	nextConsumer := new(consumertest.TracesSink)
+	sampledPolicy := &mockPolicyEvaluator{NextDecision: sampling.Sampled}
+	dropSubPolicy := &mockPolicyEvaluator{NextDecision: sampling.NotSampled}
+	tsp := &tailSamplingSpanProcessor{
+		ctx:             context.Background(),
+		nextConsumer:    nextConsumer,
+		maxNumTraces:    maxSize,
+		logger:          zap.NewNop(),
+		decisionBatcher: newSyncIDBatcher(1),
+		policies: []*policy{
+			{name: "sampled-policy", evaluator: sampledPolicy, ctx: context.TODO()},
+			{name: "drop-policy", evaluator: sampling.NewDrop(zap.NewNop(), []sampling.PolicyEvaluator{dropSubPolicy}), ctx: context.TODO()},
+		},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      &manualTTicker{},
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    &atomic.Uint64{},
+		sampledIDCache:    cache.NewNopCache(),
+		nonSampledIDCache: cache.NewNopCache(),
+	}
+	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, tsp.Shutdown(context.Background()))
+	}()
+
+	traceID := uInt64ToTraceID(1)
+	require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(traceID)))
+	tsp.samplingPolicyOnTick()
+	tsp.samplingPolicyOnTick()
+
+	require.EqualValues(t, 1, sampledPolicy.EvaluationCount)
+	require.EqualValues(t, 1, dropSubPolicy.EvaluationCount)
+	require.EqualValues(t, 1, nextConsumer.SpanCount(), "trace not matching the drop policy should be sampled")
+}
+
 func TestLateArrivingSpansAssignedOriginalDecision(t *testing.T) {
 	const maxSize = 100
 	nextConsumer := new(consumertest.TracesSink)
@@ -502,10 +591,12 @@ func TestLateArrivingSpansAssignedOriginalDecision(t *testing.T) {
 			{name: "mock-policy-1", evaluator: mpe1, ctx: context.TODO()},
 			{name: "mock-policy-2", evaluator: mpe2, ctx: context.TODO()},
 		},
-		deleteChan:      make(chan pcommon.TraceID, maxSize),
-		policyTicker:    &manualTTicker{},
-		tickerFrequency: 100 * time.Millisecond,
-		numTracesOnMap:  &atomic.Uint64{},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      &manualTTicker{},
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    &atomic.Uint64{},
+		sampledIDCache:    cache.NewNopCache(),
+		nonSampledIDCache: cache.NewNopCache(),
 	}
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
@@ -554,6 +645,62 @@ func TestLateArrivingSpansAssignedOriginalDecision(t *testing.T) {
 	require.EqualValues(t, 0, nextConsumer.SpanCount(), "original final decision not honored")
 }
 
+func TestLateArrivingSpansFollowCachedDecision(t *testing.T) {
+	const maxSize = 100
+	for _, tt := range []struct {
+		name              string
+		decision          sampling.Decision
+		expectedSpanCount int
+	}{
+		{name: "sampled", decision: sampling.Sampled, expectedSpanCount: 2},
+		{name: "not sampled", decision: sampling.NotSampled, expectedSpanCount: 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			nextConsumer := new(consumertest.TracesSink)
+			mpe := &mockPolicyEvaluator{}
+			tsp := &tailSamplingSpanProcessor{
+				ctx:               context.Background(),
+				nextConsumer:      nextConsumer,
+				maxNumTraces:      maxSize,
+				logger:            zap.NewNop(),
+				decisionBatcher:   newSyncIDBatcher(1),
+				policies:          []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+				deleteChan:        make(chan pcommon.TraceID, maxSize),
+				policyTicker:      &manualTTicker{},
+				tickerFrequency:   100 * time.Millisecond,
+				numTracesOnMap:    &atomic.Uint64{},
+				sampledIDCache:    cache.NewLRUCache(maxSize, nil),
+				nonSampledIDCache: cache.NewLRUCache(maxSize, nil),
+			}
+			require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
+			defer func() {
+				require.NoError(t, tsp.Shutdown(context.Background()))
+			}()
+
+			traceID := uInt64ToTraceID(1)
+			mpe.NextDecision = tt.decision
+
+			require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(traceID)))
+			tsp.samplingPolicyOnTick()
+			tsp.samplingPolicyOnTick()
+			require.EqualValues(t, 1, mpe.EvaluationCount)
+
+			// Release the trace from memory, as if it was pushed out by newer traces.
+			tsp.dropTrace(traceID, time.Now())
+
+			// The late span follows the original decision instead of starting a new trace.
+			require.NoError(t, tsp.ConsumeTraces(context.Background(), simpleTracesWithID(traceID)))
+			_, ok := tsp.idToTrace.Load(traceID)
+			require.False(t, ok, "late span should not start a new trace")
+			require.EqualValues(t, tt.expectedSpanCount, nextConsumer.SpanCount())
+
+			tsp.samplingPolicyOnTick()
+			tsp.samplingPolicyOnTick()
+			require.EqualValues(t, 1, mpe.EvaluationCount, "trace should not be evaluated again")
+		})
+	}
+}
+
 func TestMultipleBatchesAreCombinedIntoOne(t *testing.T) {
 	const maxSize = 100
 	const decisionWaitSeconds = 1
@@ -563,16 +710,18 @@ func TestMultipleBatchesAreCombinedIntoOne(t *testing.T) {
 	mpe := &mockPolicyEvaluator{}
 	mtt := &manualTTicker{}
 	tsp := &tailSamplingSpanProcessor{
-		ctx:             context.Background(),
-		nextConsumer:    msp,
-		maxNumTraces:    maxSize,
-		logger:          zap.NewNop(),
-		decisionBatcher: newSyncIDBatcher(decisionWaitSeconds),
-		policies:        []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
-		deleteChan:      make(chan pcommon.TraceID, maxSize),
-		policyTicker:    mtt,
-		tickerFrequency: 100 * time.Millisecond,
-		numTracesOnMap:  &atomic.Uint64{},
+		ctx:               context.Background(),
+		nextConsumer:      msp,
+		maxNumTraces:      maxSize,
+		logger:            zap.NewNop(),
+		decisionBatcher:   newSyncIDBatcher(decisionWaitSeconds),
+		policies:          []*policy{{name: "mock-policy", evaluator: mpe, ctx: context.TODO()}},
+		deleteChan:        make(chan pcommon.TraceID, maxSize),
+		policyTicker:      mtt,
+		tickerFrequency:   100 * time.Millisecond,
+		numTracesOnMap:    &atomic.Uint64{},
+		sampledIDCache:    cache.NewNopCache(),
+		nonSampledIDCache: cache.NewNopCache(),
 	}
 	require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
 	defer func() {
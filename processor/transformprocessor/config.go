@@ -32,7 +32,7 @@ var _ component.Config = (*Config)(nil)
 
 func (c *Config) Validate() error {
 	if len(c.TraceStatements) > 0 {
-		pc, err := common.NewTraceParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithSpanParser(traces.SpanFunctions()), common.WithSpanEventParser(traces.SpanEventFunctions()))
+		pc, err := common.NewTraceParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithSpanParser(traces.SpanFunctions()), common.WithSpanEventParser(traces.SpanEventFunctions()), common.WithSpanLinkParser(traces.SpanLinkFunctions()))
 		if err != nil {
 			return err
 		}
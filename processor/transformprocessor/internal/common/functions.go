@@ -20,6 +20,11 @@ func Functions[K any]() map[string]ottl.Factory[K] {
 		ottlfuncs.NewIntFactory[K](),
 		ottlfuncs.NewConvertCaseFactory[K](),
 		ottlfuncs.NewParseJSONFactory[K](),
+		ottlfuncs.NewParseKeyValueFactory[K](),
+		ottlfuncs.NewExtractPatternsFactory[K](),
+		ottlfuncs.NewParseCSVFactory[K](),
+		ottlfuncs.NewParseXMLFactory[K](),
+		ottlfuncs.NewURLFactory[K](),
 		ottlfuncs.NewSubstringFactory[K](),
 		ottlfuncs.NewKeepKeysFactory[K](),
 		ottlfuncs.NewSetFactory[K](),
@@ -33,6 +38,15 @@ func Functions[K any]() map[string]ottl.Factory[K] {
 		ottlfuncs.NewDeleteMatchingKeysFactory[K](),
 		ottlfuncs.NewMergeMapsFactory[K](),
 		ottlfuncs.NewLogFactory[K](),
+		ottlfuncs.NewTimeFactory[K](),
+		ottlfuncs.NewFormatTimeFactory[K](),
+		ottlfuncs.NewNowFactory[K](),
+		ottlfuncs.NewDurationFactory[K](),
+		ottlfuncs.NewUnixFactory[K](),
+		ottlfuncs.NewUnixSecondsFactory[K](),
+		ottlfuncs.NewUnixMilliFactory[K](),
+		ottlfuncs.NewUnixMicroFactory[K](),
+		ottlfuncs.NewUnixNanoFactory[K](),
 	)
 }
 
@@ -317,6 +317,44 @@ func Test_ProcessLogs_LogContext(t *testing.T) {
 				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("json_test", "pass")
 			},
 		},
+		{
+			statement: `merge_maps(attributes, ParseKeyValue("k1=v1 k2=v2", "=", " "), "insert") where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("k1", "v1")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("k2", "v2")
+			},
+		},
+		{
+			statement: `merge_maps(attributes, ExtractPatterns(body, "^operation(?P<operation>\\w+)$"), "insert") where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("operation", "A")
+			},
+		},
+		{
+			statement: `merge_maps(attributes, ParseCSV("a,b", "first,second", ","), "insert") where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("first", "a")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("second", "b")
+			},
+		},
+		{
+			statement: `set(attributes["test"], ParseXML("<Log>pass</Log>")) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				m := td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutEmptyMap("test")
+				m.PutStr("tag", "Log")
+				m.PutStr("content", "pass")
+			},
+		},
+		{
+			statement: `merge_maps(attributes, URL(attributes["http.url"]), "insert") where body == "operationA"`,
+			want: func(td plog.Logs) {
+				attrs := td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+				attrs.PutStr("url.original", "http://localhost/health")
+				attrs.PutStr("url.scheme", "http")
+				attrs.PutStr("url.domain", "localhost")
+				attrs.PutStr("url.path", "/health")
+			},
+		},
 		{
 			statement: `limit(attributes, 0, []) where body == "operationA"`,
 			want: func(td plog.Logs) {
@@ -329,6 +367,25 @@ func Test_ProcessLogs_LogContext(t *testing.T) {
 				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutDouble("test", 0.0)
 			},
 		},
+		{
+			statement: `set(time, Time("2023-04-12 10:45:30", "%Y-%m-%d %H:%M:%S")) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).SetTimestamp(pcommon.NewTimestampFromTime(time.Date(2023, 4, 12, 10, 45, 30, 0, time.UTC)))
+			},
+		},
+		{
+			statement: `set(attributes["test"], FormatTime(time, "%Y-%m-%d")) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "2020-02-11")
+			},
+		},
+		{
+			statement: `set(attributes["test"], "pass") where observed_time - time == Duration("1.000000468s")`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "pass")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("test", "pass")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanlink"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 )
 
@@ -31,3 +32,12 @@ func Test_SpanEventFunctions(t *testing.T) {
 		assert.Contains(t, expected, k)
 	}
 }
+
+func Test_SpanLinkFunctions(t *testing.T) {
+	expected := common.Functions[ottlspanlink.TransformContext]()
+	actual := SpanLinkFunctions()
+	require.Equal(t, len(expected), len(actual))
+	for k := range actual {
+		assert.Contains(t, expected, k)
+	}
+}
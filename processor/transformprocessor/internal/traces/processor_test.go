@@ -374,6 +374,25 @@ func Test_ProcessTraces_TraceContext(t *testing.T) {
 				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().PutDouble("test", 0.0)
 			},
 		},
+		{
+			statement: `set(attributes["test"], "pass") where end_time - start_time > Duration("1s")`,
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().PutStr("test", "pass")
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statement: `set(attributes["test"], UnixNano(end_time) - UnixNano(start_time)) where name == "operationA"`,
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().PutInt("test", 1000000468)
+			},
+		},
+		{
+			statement: `set(end_time, start_time + Duration("5s")) where name == "operationA"`,
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SetEndTimestamp(pcommon.NewTimestampFromTime(TestSpanStartTime.Add(5 * time.Second)))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -423,6 +442,50 @@ func Test_ProcessTraces_SpanEventContext(t *testing.T) {
 	}
 }
 
+func Test_ProcessTraces_SpanLinkContext(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      func(td ptrace.Traces)
+	}{
+		{
+			statement: `set(attributes["test"], "pass") where span.name == "operationB"`,
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Links().At(0).Attributes().PutStr("test", "pass")
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Links().At(1).Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statement: `delete_key(attributes, "user.email")`,
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Links().At(0).Attributes().Remove("user.email")
+			},
+		},
+		{
+			statement: `set(trace_state["test"], "pass") where dropped_attributes_count == 4 and resource.attributes["host.name"] == "localhost"`,
+			want: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Links().At(0).TraceState().FromRaw("test=pass")
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Links().At(1).TraceState().FromRaw("test=pass")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			td := constructTraces()
+			processor, err := NewProcessor([]common.ContextStatements{{Context: "spanlink", Statements: []string{tt.statement}}}, ottl.IgnoreError, componenttest.NewNopTelemetrySettings())
+			assert.NoError(t, err)
+
+			_, err = processor.ProcessTraces(context.Background(), td)
+			assert.NoError(t, err)
+
+			exTd := constructTraces()
+			tt.want(exTd)
+
+			assert.Equal(t, exTd, td)
+		})
+	}
+}
+
 func Test_ProcessTraces_MixContext(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -557,6 +620,9 @@ func Test_ProcessTraces_Error(t *testing.T) {
 		{
 			context: "spanevent",
 		},
+		{
+			context: "spanlink",
+		},
 	}
 
 	for _, tt := range tests {
@@ -717,6 +783,7 @@ func fillSpanTwo(span ptrace.Span) {
 	span.Attributes().PutStr("total.string", "345678")
 	link0 := span.Links().AppendEmpty()
 	link0.SetDroppedAttributesCount(4)
+	link0.Attributes().PutStr("user.email", "user@example.com")
 	link1 := span.Links().AppendEmpty()
 	link1.SetDroppedAttributesCount(4)
 	span.SetDroppedLinksCount(3)
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package websocketprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/websocketprocessor"
+
+import "sync"
+
+// channelSet is a collection of byte channels, one per connected websocket
+// client, that can be written to all at once.
+type channelSet struct {
+	mu   sync.Mutex
+	idx  int
+	chms map[int]chan []byte
+}
+
+func newChannelSet() *channelSet {
+	return &channelSet{
+		chms: make(map[int]chan []byte),
+	}
+}
+
+// add adds the channel to the set and returns its key.
+func (c *channelSet) add(ch chan []byte) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	idx := c.idx
+	c.chms[idx] = ch
+	c.idx++
+	return idx
+}
+
+// writeBytes sends the bytes to every channel in the set. Channels that are
+// not ready to receive are skipped, so a slow client never blocks the
+// pipeline; that client simply misses the message.
+func (c *channelSet) writeBytes(bytes []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, ch := range c.chms {
+		select {
+		case ch <- bytes:
+		default:
+		}
+	}
+}
+
+// closeAndRemove closes the channel with the given key and removes it from
+// the set. It is a no-op if the key is unknown.
+func (c *channelSet) closeAndRemove(key int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ch, ok := c.chms[key]; ok {
+		close(ch)
+		delete(c.chms, key)
+	}
+}
+
+// closeAll closes and removes all channels of the set.
+func (c *channelSet) closeAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, ch := range c.chms {
+		close(ch)
+		delete(c.chms, key)
+	}
+}
+
+// len returns the number of channels in the set.
+func (c *channelSet) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.chms)
+}
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package websocketprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelSet(t *testing.T) {
+	cs := newChannelSet()
+	ch1 := make(chan []byte, 1)
+	ch2 := make(chan []byte, 1)
+	idx1 := cs.add(ch1)
+	cs.add(ch2)
+	assert.Equal(t, 2, cs.len())
+
+	cs.writeBytes([]byte("hello"))
+	assert.Equal(t, "hello", string(<-ch1))
+	assert.Equal(t, "hello", string(<-ch2))
+
+	cs.closeAndRemove(idx1)
+	assert.Equal(t, 1, cs.len())
+	_, ok := <-ch1
+	assert.False(t, ok)
+	// Removing twice is a no-op.
+	cs.closeAndRemove(idx1)
+
+	cs.closeAll()
+	assert.Equal(t, 0, cs.len())
+	_, ok = <-ch2
+	assert.False(t, ok)
+}
+
+func TestChannelSetSkipsFullChannels(t *testing.T) {
+	cs := newChannelSet()
+	ch := make(chan []byte, 1)
+	cs.add(ch)
+
+	cs.writeBytes([]byte("first"))
+	cs.writeBytes([]byte("second"))
+	assert.Equal(t, "first", string(<-ch))
+	assert.Len(t, ch, 0)
+}
@@ -4,12 +4,15 @@
 package websocketprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/websocketprocessor"
 
 import (
+	"errors"
+
 	"go.opentelemetry.io/collector/component"
 	"golang.org/x/time/rate"
 )
 
 const defaultPort = 12001
 
+// Config defines configuration for the websocket processor.
 type Config struct {
 	// Port indicates the port used by the web socket listener started by this processor.
 	// Defaults to 12001.
@@ -19,6 +22,19 @@ type Config struct {
 	Limit rate.Limit `mapstructure:"limit"`
 }
 
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Port < 1 || cfg.Port > 65535 {
+		return errors.New("port must be between 1 and 65535")
+	}
+	if cfg.Limit <= 0 {
+		return errors.New("limit must be larger than zero")
+	}
+	return nil
+}
+
 func createDefaultConfig() component.Config {
 	return &Config{
 		Port:  defaultPort,
@@ -14,3 +14,15 @@ func TestCreateDefaultConfig(t *testing.T) {
 	assert.Equal(t, 12001, cfg.Port)
 	assert.EqualValues(t, 1, cfg.Limit)
 }
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Port = 0
+	assert.EqualError(t, cfg.Validate(), "port must be between 1 and 65535")
+
+	cfg.Port = defaultPort
+	cfg.Limit = 0
+	assert.EqualError(t, cfg.Validate(), "limit must be larger than zero")
+}
@@ -4,8 +4,14 @@
 package websocketprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/websocketprocessor"
 
 import (
+	"context"
+
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 )
 
 const (
@@ -13,9 +19,82 @@ const (
 	stability = component.StabilityLevelDevelopment
 )
 
+var processorCapabilities = consumer.Capabilities{MutatesData: false}
+
+// processors shares a single websocket server between the traces, metrics
+// and logs pipelines that use the same processor configuration.
+var processors = sharedcomponent.NewSharedComponents()
+
+// NewFactory returns a new factory for the websocket processor.
 func NewFactory() processor.Factory {
 	return processor.NewFactory(
 		typeStr,
 		createDefaultConfig,
+		processor.WithTraces(createTracesProcessor, stability),
+		processor.WithMetrics(createMetricsProcessor, stability),
+		processor.WithLogs(createLogsProcessor, stability),
+	)
+}
+
+func createTracesProcessor(
+	ctx context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	next consumer.Traces,
+) (processor.Traces, error) {
+	w := processors.GetOrAdd(cfg, func() component.Component {
+		return newProcessor(set, cfg.(*Config))
+	})
+	return processorhelper.NewTracesProcessor(
+		ctx,
+		set,
+		cfg,
+		next,
+		w.Unwrap().(*wsprocessor).processTraces,
+		processorhelper.WithStart(w.Start),
+		processorhelper.WithShutdown(w.Shutdown),
+		processorhelper.WithCapabilities(processorCapabilities),
+	)
+}
+
+func createMetricsProcessor(
+	ctx context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	next consumer.Metrics,
+) (processor.Metrics, error) {
+	w := processors.GetOrAdd(cfg, func() component.Component {
+		return newProcessor(set, cfg.(*Config))
+	})
+	return processorhelper.NewMetricsProcessor(
+		ctx,
+		set,
+		cfg,
+		next,
+		w.Unwrap().(*wsprocessor).processMetrics,
+		processorhelper.WithStart(w.Start),
+		processorhelper.WithShutdown(w.Shutdown),
+		processorhelper.WithCapabilities(processorCapabilities),
+	)
+}
+
+func createLogsProcessor(
+	ctx context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	next consumer.Logs,
+) (processor.Logs, error) {
+	w := processors.GetOrAdd(cfg, func() component.Component {
+		return newProcessor(set, cfg.(*Config))
+	})
+	return processorhelper.NewLogsProcessor(
+		ctx,
+		set,
+		cfg,
+		next,
+		w.Unwrap().(*wsprocessor).processLogs,
+		processorhelper.WithStart(w.Start),
+		processorhelper.WithShutdown(w.Shutdown),
+		processorhelper.WithCapabilities(processorCapabilities),
 	)
 }
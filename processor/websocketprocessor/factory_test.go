@@ -4,9 +4,14 @@
 package websocketprocessor
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/processor/processortest"
 )
 
 func TestNewFactory(t *testing.T) {
@@ -14,4 +19,29 @@ func TestNewFactory(t *testing.T) {
 	assert.EqualValues(t, "websocket", factory.Type())
 	config := factory.CreateDefaultConfig()
 	assert.NotNil(t, config)
+	assert.NoError(t, componenttest.CheckConfigStruct(config))
+}
+
+func TestCreateProcessorsShareServer(t *testing.T) {
+	factory := NewFactory()
+	cfg := testConfig(t)
+	set := processortest.NewNopCreateSettings()
+
+	tp, err := factory.CreateTracesProcessor(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	mp, err := factory.CreateMetricsProcessor(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	lp, err := factory.CreateLogsProcessor(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.False(t, tp.Capabilities().MutatesData)
+
+	// Starting all three only binds the port once.
+	host := componenttest.NewNopHost()
+	require.NoError(t, tp.Start(context.Background(), host))
+	require.NoError(t, mp.Start(context.Background(), host))
+	require.NoError(t, lp.Start(context.Background(), host))
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+	require.NoError(t, mp.Shutdown(context.Background()))
+	require.NoError(t, lp.Shutdown(context.Background()))
 }
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package websocketprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/websocketprocessor"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.uber.org/zap"
+	"golang.org/x/net/websocket"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// connBufferSize is the number of messages buffered for a client before
+	// new messages are dropped for it.
+	connBufferSize = 16
+	// serverReadHeaderTimeout bounds the time a client may take to send the
+	// websocket handshake.
+	serverReadHeaderTimeout = 10 * time.Second
+)
+
+var (
+	logMarshaler    = &plog.JSONMarshaler{}
+	metricMarshaler = &pmetric.JSONMarshaler{}
+	traceMarshaler  = &ptrace.JSONMarshaler{}
+)
+
+// wsprocessor passes all data through unchanged and mirrors a rate limited
+// copy of it, encoded as OTLP JSON, to the connected websocket clients.
+type wsprocessor struct {
+	config     *Config
+	logger     *zap.Logger
+	server     *http.Server
+	shutdownWG sync.WaitGroup
+	cs         *channelSet
+	limiter    *rate.Limiter
+}
+
+func newProcessor(settings processor.CreateSettings, config *Config) *wsprocessor {
+	return &wsprocessor{
+		config:  config,
+		logger:  settings.Logger,
+		cs:      newChannelSet(),
+		limiter: rate.NewLimiter(config.Limit, 1),
+	}
+}
+
+// Start starts the websocket server.
+func (w *wsprocessor) Start(_ context.Context, host component.Host) error {
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", w.config.Port))
+	if err != nil {
+		return fmt.Errorf("failed to bind to port %d: %w", w.config.Port, err)
+	}
+	w.server = &http.Server{
+		Handler:           websocket.Handler(w.handleConn),
+		ReadHeaderTimeout: serverReadHeaderTimeout,
+	}
+	w.shutdownWG.Add(1)
+	go func() {
+		defer w.shutdownWG.Done()
+		if errHTTP := w.server.Serve(ln); errHTTP != nil && !errors.Is(errHTTP, http.ErrServerClosed) {
+			host.ReportFatalError(errHTTP)
+		}
+	}()
+	return nil
+}
+
+// handleConn writes the messages to the client until the connection fails
+// or the processor is shut down.
+func (w *wsprocessor) handleConn(conn *websocket.Conn) {
+	ch := make(chan []byte, connBufferSize)
+	idx := w.cs.add(ch)
+	for bytes := range ch {
+		if _, err := conn.Write(bytes); err != nil {
+			w.logger.Debug("websocket write error", zap.Error(err))
+			w.cs.closeAndRemove(idx)
+			break
+		}
+	}
+}
+
+// Shutdown stops the websocket server and disconnects all clients.
+func (w *wsprocessor) Shutdown(context.Context) error {
+	if w.server == nil {
+		return nil
+	}
+	err := w.server.Close()
+	w.cs.closeAll()
+	w.shutdownWG.Wait()
+	return err
+}
+
+func (w *wsprocessor) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	if w.cs.len() > 0 && w.limiter.Allow() {
+		b, err := traceMarshaler.MarshalTraces(td)
+		if err != nil {
+			w.logger.Debug("Error serializing to JSON", zap.Error(err))
+		} else {
+			w.cs.writeBytes(b)
+		}
+	}
+	return td, nil
+}
+
+func (w *wsprocessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	if w.cs.len() > 0 && w.limiter.Allow() {
+		b, err := metricMarshaler.MarshalMetrics(md)
+		if err != nil {
+			w.logger.Debug("Error serializing to JSON", zap.Error(err))
+		} else {
+			w.cs.writeBytes(b)
+		}
+	}
+	return md, nil
+}
+
+func (w *wsprocessor) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+	if w.cs.len() > 0 && w.limiter.Allow() {
+		b, err := logMarshaler.MarshalLogs(ld)
+		if err != nil {
+			w.logger.Debug("Error serializing to JSON", zap.Error(err))
+		} else {
+			w.cs.writeBytes(b)
+		}
+	}
+	return ld, nil
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package websocketprocessor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"golang.org/x/net/websocket"
+	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func testConfig(t *testing.T) *Config {
+	_, portStr, err := net.SplitHostPort(testutil.GetAvailableLocalAddress(t))
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+	return &Config{Port: port, Limit: rate.Inf}
+}
+
+func dial(t *testing.T, cfg *Config, p *wsprocessor) *websocket.Conn {
+	url := fmt.Sprintf("ws://localhost:%d", cfg.Port)
+	conn, err := websocket.Dial(url, "", "http://localhost")
+	require.NoError(t, err)
+	// Wait for the server to register the connection.
+	require.Eventually(t, func() bool { return p.cs.len() == 1 }, 5*time.Second, 10*time.Millisecond)
+	return conn
+}
+
+func TestProcessorPassesDataThrough(t *testing.T) {
+	cfg := testConfig(t)
+	sink := new(consumertest.LogsSink)
+	proc, err := NewFactory().CreateLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, proc.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, proc.Shutdown(context.Background())) }()
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("hello")
+	require.NoError(t, proc.ConsumeLogs(context.Background(), ld))
+	require.Len(t, sink.AllLogs(), 1)
+	assert.Equal(t, ld, sink.AllLogs()[0])
+}
+
+func TestProcessorMirrorsToWebsocket(t *testing.T) {
+	cfg := testConfig(t)
+	p := newProcessor(processortest.NewNopCreateSettings(), cfg)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, p.Shutdown(context.Background())) }()
+
+	conn := dial(t, cfg, p)
+	defer conn.Close()
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("hello")
+	_, err := p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+	assertReceived(t, conn, "resourceLogs")
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("m")
+	_, err = p.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	assertReceived(t, conn, "resourceMetrics")
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("s")
+	_, err = p.processTraces(context.Background(), td)
+	require.NoError(t, err)
+	assertReceived(t, conn, "resourceSpans")
+}
+
+func TestProcessorRateLimit(t *testing.T) {
+	cfg := testConfig(t)
+	cfg.Limit = rate.Every(time.Hour)
+	p := newProcessor(processortest.NewNopCreateSettings(), cfg)
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, p.Shutdown(context.Background())) }()
+
+	conn := dial(t, cfg, p)
+	defer conn.Close()
+
+	for i := 0; i < 3; i++ {
+		ld := plog.NewLogs()
+		ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(strconv.Itoa(i))
+		_, err := p.processLogs(context.Background(), ld)
+		require.NoError(t, err)
+	}
+	assertReceived(t, conn, `"stringValue":"0"`)
+
+	// Only the first message made it through the limiter.
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(100*time.Millisecond)))
+	var msg string
+	assert.Error(t, websocket.Message.Receive(conn, &msg))
+}
+
+func TestStartPortInUse(t *testing.T) {
+	ln, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	cfg := &Config{Port: ln.Addr().(*net.TCPAddr).Port, Limit: 1}
+	p := newProcessor(processortest.NewNopCreateSettings(), cfg)
+	assert.Error(t, p.Start(context.Background(), componenttest.NewNopHost()))
+}
+
+func assertReceived(t *testing.T, conn *websocket.Conn, substr string) {
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var msg string
+	require.NoError(t, websocket.Message.Receive(conn, &msg))
+	assert.Contains(t, msg, substr)
+}
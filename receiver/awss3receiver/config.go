@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// S3DownloaderConfig contains the settings locating the objects written by the awss3exporter.
+// They match the s3uploader settings of the exporter.
+type S3DownloaderConfig struct {
+	Region      string `mapstructure:"region"`
+	S3Bucket    string `mapstructure:"s3_bucket"`
+	S3Prefix    string `mapstructure:"s3_prefix"`
+	S3Partition string `mapstructure:"s3_partition"`
+	FilePrefix  string `mapstructure:"file_prefix"`
+	// Endpoint overrides the S3 endpoint, to use S3 compatible object stores.
+	Endpoint string `mapstructure:"endpoint"`
+	// S3ForcePathStyle puts the bucket in the path of the object URLs rather than in the host name.
+	S3ForcePathStyle bool `mapstructure:"s3_force_path_style"`
+	// DisableSSL makes the downloader talk plain HTTP to the endpoint.
+	DisableSSL bool `mapstructure:"disable_ssl"`
+}
+
+type MarshalerType string
+
+const (
+	OtlpJSON     MarshalerType = "otlp_json"
+	OtlpProtobuf MarshalerType = "otlp_proto"
+)
+
+// Config defines configuration for the S3 receiver.
+type Config struct {
+	S3Downloader  S3DownloaderConfig `mapstructure:"s3downloader"`
+	MarshalerName MarshalerType      `mapstructure:"marshaler"`
+
+	// StartTime and EndTime delimit the time window of the replayed objects.
+	StartTime string `mapstructure:"start_time"`
+	EndTime   string `mapstructure:"end_time"`
+
+	// MaxObjectsPerSecond limits the rate the objects are replayed at, no limit when zero.
+	MaxObjectsPerSecond float64 `mapstructure:"max_objects_per_second"`
+}
+
+// maxObjectsPerSecond is the highest supported replay rate, above it the objects are better
+// replayed without a limit.
+const maxObjectsPerSecond = 1_000_000
+
+var (
+	errMissingBucket = errors.New("s3_bucket is required")
+	errMissingWindow = errors.New("start_time and end_time are required")
+	errEmptyWindow   = errors.New("end_time must be after start_time")
+	errNegativeRate  = errors.New("max_objects_per_second must not be negative")
+	errRateTooHigh   = fmt.Errorf("max_objects_per_second must not be greater than %d", maxObjectsPerSecond)
+)
+
+// timeLayouts are the accepted formats of start_time and end_time, the ones
+// without a time zone are in UTC.
+var timeLayouts = []string{time.RFC3339, "2006-01-02 15:04", "2006-01-02"}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks the receiver configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.S3Downloader.S3Bucket == "" {
+		return errMissingBucket
+	}
+	switch cfg.S3Downloader.S3Partition {
+	case "minute", "hour":
+	default:
+		return fmt.Errorf("s3_partition must be minute or hour, got %q", cfg.S3Downloader.S3Partition)
+	}
+	switch cfg.MarshalerName {
+	case OtlpJSON, OtlpProtobuf:
+	default:
+		return fmt.Errorf("unknown marshaler %q", cfg.MarshalerName)
+	}
+	if cfg.MaxObjectsPerSecond < 0 {
+		return errNegativeRate
+	}
+	if cfg.MaxObjectsPerSecond > maxObjectsPerSecond {
+		return errRateTooHigh
+	}
+	start, end, err := cfg.window()
+	if err != nil {
+		return err
+	}
+	if !end.After(start) {
+		return errEmptyWindow
+	}
+	return nil
+}
+
+// window returns the parsed time window of the replay.
+func (cfg *Config) window() (time.Time, time.Time, error) {
+	if cfg.StartTime == "" || cfg.EndTime == "" {
+		return time.Time{}, time.Time{}, errMissingWindow
+	}
+	start, err := parseTime(cfg.StartTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start_time: %w", err)
+	}
+	end, err := parseTime(cfg.EndTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end_time: %w", err)
+	}
+	return start, end, nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is neither RFC3339, \"YYYY-MM-DD HH:MM\" nor \"YYYY-MM-DD\"", value)
+}
@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr error
+	}{
+		{
+			id:          component.NewID(metadata.Type),
+			expectedErr: errMissingBucket,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "replay"),
+			expected: &Config{
+				S3Downloader: S3DownloaderConfig{
+					Region:           "eu-central-1",
+					S3Bucket:         "databucket",
+					S3Prefix:         "metric",
+					S3Partition:      "hour",
+					FilePrefix:       "app",
+					Endpoint:         "http://localhost:9000",
+					S3ForcePathStyle: true,
+					DisableSSL:       true,
+				},
+				MarshalerName:       OtlpProtobuf,
+				StartTime:           "2023-05-04 10:00",
+				EndTime:             "2023-05-04T12:30:00Z",
+				MaxObjectsPerSecond: 20,
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_bucket"),
+			expectedErr: errMissingBucket,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_window"),
+			expectedErr: errMissingWindow,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "empty_window"),
+			expectedErr: errEmptyWindow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(cfg *Config)
+		expectedErr string
+	}{
+		{
+			name: "unknown partition",
+			modify: func(cfg *Config) {
+				cfg.S3Downloader.S3Partition = "day"
+			},
+			expectedErr: `s3_partition must be minute or hour, got "day"`,
+		},
+		{
+			name: "unknown marshaler",
+			modify: func(cfg *Config) {
+				cfg.MarshalerName = "ndjson"
+			},
+			expectedErr: `unknown marshaler "ndjson"`,
+		},
+		{
+			name: "negative rate",
+			modify: func(cfg *Config) {
+				cfg.MaxObjectsPerSecond = -1
+			},
+			expectedErr: errNegativeRate.Error(),
+		},
+		{
+			name: "rate too high",
+			modify: func(cfg *Config) {
+				cfg.MaxObjectsPerSecond = 2e9
+			},
+			expectedErr: "max_objects_per_second must not be greater than 1000000",
+		},
+		{
+			name: "invalid start time",
+			modify: func(cfg *Config) {
+				cfg.StartTime = "yesterday"
+			},
+			expectedErr: `invalid start_time: "yesterday" is neither RFC3339, "YYYY-MM-DD HH:MM" nor "YYYY-MM-DD"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.S3Downloader.S3Bucket = "databucket"
+			cfg.StartTime = "2023-05-04"
+			cfg.EndTime = "2023-05-05"
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.expectedErr)
+		})
+	}
+}
+
+func TestConfigWindow(t *testing.T) {
+	cfg := &Config{StartTime: "2023-05-04 10:00", EndTime: "2023-05-04T12:30:00+02:00"}
+	start, end, err := cfg.window()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 5, 4, 10, 0, 0, 0, time.UTC), start.UTC())
+	assert.Equal(t, time.Date(2023, 5, 4, 10, 30, 0, 0, time.UTC), end.UTC())
+}
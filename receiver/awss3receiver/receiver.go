@@ -0,0 +1,234 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+)
+
+const transport = "s3"
+
+// awss3Receiver replays the objects written for one signal in the configured time window.
+type awss3Receiver struct {
+	settings receiver.CreateSettings
+	cfg      *Config
+	signal   string
+	// consumeObject decodes the content of an object and sends it to the next consumer.
+	consumeObject func(ctx context.Context, buf []byte) error
+	newReader     func(cfg S3DownloaderConfig) (s3Reader, error)
+
+	cancel     context.CancelFunc
+	shutdownWG sync.WaitGroup
+}
+
+var _ receiver.Traces = (*awss3Receiver)(nil)
+var _ receiver.Metrics = (*awss3Receiver)(nil)
+var _ receiver.Logs = (*awss3Receiver)(nil)
+
+func newReceiver(params receiver.CreateSettings, cfg *Config, signal string, consumeObject func(context.Context, []byte) error) *awss3Receiver {
+	return &awss3Receiver{
+		settings:      params,
+		cfg:           cfg,
+		signal:        signal,
+		consumeObject: consumeObject,
+		newReader: func(cfg S3DownloaderConfig) (s3Reader, error) {
+			return newAWSS3Reader(cfg)
+		},
+	}
+}
+
+func newObsReceiver(params receiver.CreateSettings) (*obsreport.Receiver, error) {
+	return obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             params.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: params,
+	})
+}
+
+func newTracesReceiver(params receiver.CreateSettings, cfg *Config, nextConsumer consumer.Traces) (*awss3Receiver, error) {
+	if nextConsumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	obsrecv, err := newObsReceiver(params)
+	if err != nil {
+		return nil, err
+	}
+	var unmarshaler ptrace.Unmarshaler = &ptrace.JSONUnmarshaler{}
+	if cfg.MarshalerName == OtlpProtobuf {
+		unmarshaler = &ptrace.ProtoUnmarshaler{}
+	}
+	return newReceiver(params, cfg, "traces", func(ctx context.Context, buf []byte) error {
+		ctx = obsrecv.StartTracesOp(ctx)
+		td, err := unmarshaler.UnmarshalTraces(buf)
+		if err != nil {
+			obsrecv.EndTracesOp(ctx, string(cfg.MarshalerName), 0, err)
+			return err
+		}
+		err = nextConsumer.ConsumeTraces(ctx, td)
+		obsrecv.EndTracesOp(ctx, string(cfg.MarshalerName), td.SpanCount(), err)
+		return err
+	}), nil
+}
+
+func newMetricsReceiver(params receiver.CreateSettings, cfg *Config, nextConsumer consumer.Metrics) (*awss3Receiver, error) {
+	if nextConsumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	obsrecv, err := newObsReceiver(params)
+	if err != nil {
+		return nil, err
+	}
+	var unmarshaler pmetric.Unmarshaler = &pmetric.JSONUnmarshaler{}
+	if cfg.MarshalerName == OtlpProtobuf {
+		unmarshaler = &pmetric.ProtoUnmarshaler{}
+	}
+	return newReceiver(params, cfg, "metrics", func(ctx context.Context, buf []byte) error {
+		ctx = obsrecv.StartMetricsOp(ctx)
+		md, err := unmarshaler.UnmarshalMetrics(buf)
+		if err != nil {
+			obsrecv.EndMetricsOp(ctx, string(cfg.MarshalerName), 0, err)
+			return err
+		}
+		err = nextConsumer.ConsumeMetrics(ctx, md)
+		obsrecv.EndMetricsOp(ctx, string(cfg.MarshalerName), md.DataPointCount(), err)
+		return err
+	}), nil
+}
+
+func newLogsReceiver(params receiver.CreateSettings, cfg *Config, nextConsumer consumer.Logs) (*awss3Receiver, error) {
+	if nextConsumer == nil {
+		return nil, component.ErrNilNextConsumer
+	}
+	obsrecv, err := newObsReceiver(params)
+	if err != nil {
+		return nil, err
+	}
+	var unmarshaler plog.Unmarshaler = &plog.JSONUnmarshaler{}
+	if cfg.MarshalerName == OtlpProtobuf {
+		unmarshaler = &plog.ProtoUnmarshaler{}
+	}
+	return newReceiver(params, cfg, "logs", func(ctx context.Context, buf []byte) error {
+		ctx = obsrecv.StartLogsOp(ctx)
+		ld, err := unmarshaler.UnmarshalLogs(buf)
+		if err != nil {
+			obsrecv.EndLogsOp(ctx, string(cfg.MarshalerName), 0, err)
+			return err
+		}
+		err = nextConsumer.ConsumeLogs(ctx, ld)
+		obsrecv.EndLogsOp(ctx, string(cfg.MarshalerName), ld.LogRecordCount(), err)
+		return err
+	}), nil
+}
+
+// Start starts replaying the objects in the background.
+func (r *awss3Receiver) Start(_ context.Context, _ component.Host) error {
+	start, end, err := r.cfg.window()
+	if err != nil {
+		return err
+	}
+	reader, err := r.newReader(r.cfg.S3Downloader)
+	if err != nil {
+		return fmt.Errorf("failed to create the S3 client: %w", err)
+	}
+
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(context.Background())
+	r.shutdownWG.Add(1)
+	go func() {
+		defer r.shutdownWG.Done()
+		r.replay(ctx, reader, start, end)
+	}()
+	return nil
+}
+
+// Shutdown stops the replay.
+func (r *awss3Receiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.shutdownWG.Wait()
+	return nil
+}
+
+// replay goes through the partitions of the time window in order, and sends the
+// objects of each of them to the next consumer.
+func (r *awss3Receiver) replay(ctx context.Context, reader s3Reader, start, end time.Time) {
+	var throttle <-chan time.Time
+	if r.cfg.MaxObjectsPerSecond > 0 {
+		// the interval is floored, a zero duration would make the ticker panic
+		interval := time.Duration(float64(time.Second) / r.cfg.MaxObjectsPerSecond)
+		if interval <= 0 {
+			interval = time.Nanosecond
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		throttle = ticker.C
+	}
+
+	downloader := r.cfg.S3Downloader
+	format := fileFormat(r.cfg.MarshalerName)
+	replayed := 0
+	for _, partition := range partitions(start, end, downloader.S3Partition) {
+		prefix := getS3Prefix(partition, downloader.S3Prefix, downloader.S3Partition, downloader.FilePrefix, r.signal)
+		keys, err := reader.listObjects(ctx, prefix)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			r.settings.Logger.Error("Failed to list the objects", zap.String("prefix", prefix), zap.Error(err))
+			continue
+		}
+		for _, key := range keys {
+			objectFormat, compression := objectFormat(key)
+			if objectFormat != format {
+				continue
+			}
+			if throttle != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case <-throttle:
+				}
+			}
+			if err = r.replayObject(ctx, reader, key, compression); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				r.settings.Logger.Error("Failed to replay the object", zap.String("key", key), zap.Error(err))
+				continue
+			}
+			replayed++
+		}
+	}
+	r.settings.Logger.Info("Finished replaying the objects",
+		zap.String("signal", r.signal), zap.Int("objects", replayed),
+		zap.Time("start_time", start), zap.Time("end_time", end))
+}
+
+func (r *awss3Receiver) replayObject(ctx context.Context, reader s3Reader, key string, compression string) error {
+	buf, err := reader.readObject(ctx, key)
+	if err != nil {
+		return err
+	}
+	if buf, err = decompress(buf, compression); err != nil {
+		return fmt.Errorf("failed to decompress the object: %w", err)
+	}
+	if len(bytes.TrimSpace(buf)) == 0 {
+		return nil
+	}
+	return r.consumeObject(ctx, buf)
+}
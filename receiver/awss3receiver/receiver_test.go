@@ -0,0 +1,240 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+var replayStart = time.Date(2023, 5, 4, 10, 0, 0, 0, time.UTC)
+
+// testS3Reader serves in memory objects, recording the objects read.
+type testS3Reader struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	read    []string
+}
+
+func (r *testS3Reader) listObjects(_ context.Context, prefix string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var keys []string
+	for key := range r.objects {
+		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (r *testS3Reader) readObject(_ context.Context, key string) ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.read = append(r.read, key)
+	content, ok := r.objects[key]
+	if !ok {
+		return nil, errors.New("no such key")
+	}
+	return content, nil
+}
+
+func (r *testS3Reader) readKeys() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.read...)
+}
+
+// objectKey returns the key the exporter writes an object under at the given time.
+func objectKey(t time.Time, signal string, id string, format string) string {
+	return getS3Prefix(t.Local(), "archive", "minute", "app", signal) + id + "." + format
+}
+
+func newTestConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.S3Downloader.S3Bucket = "databucket"
+	cfg.S3Downloader.S3Prefix = "archive"
+	cfg.S3Downloader.FilePrefix = "app"
+	cfg.StartTime = replayStart.Format(time.RFC3339)
+	cfg.EndTime = replayStart.Add(2 * time.Minute).Format(time.RFC3339)
+	return cfg
+}
+
+func newTestTraces(spanName string) ptrace.Traces {
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(spanName)
+	return td
+}
+
+func marshalJSONTraces(t *testing.T, spanName string) []byte {
+	buf, err := (&ptrace.JSONMarshaler{}).MarshalTraces(newTestTraces(spanName))
+	require.NoError(t, err)
+	return buf
+}
+
+func gzipCompress(t *testing.T, buf []byte) []byte {
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	_, err := writer.Write(buf)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return compressed.Bytes()
+}
+
+func startTestReceiver(t *testing.T, r *awss3Receiver, reader s3Reader) {
+	r.newReader = func(S3DownloaderConfig) (s3Reader, error) {
+		return reader, nil
+	}
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, r.Shutdown(context.Background()))
+	})
+}
+
+func TestReplayTraces(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	server := newFakeS3Server(t, "databucket")
+	server.putObject(objectKey(replayStart, "traces", "1", "json"), marshalJSONTraces(t, "first"))
+	server.putObject(objectKey(replayStart.Add(time.Minute), "traces", "2", "json.gz"), gzipCompress(t, marshalJSONTraces(t, "second")))
+	// outside of the time window
+	server.putObject(objectKey(replayStart.Add(2*time.Minute), "traces", "3", "json"), marshalJSONTraces(t, "late"))
+	// written for another signal, or with another marshaler
+	server.putObject(objectKey(replayStart, "logs", "4", "json"), []byte(`{}`))
+	server.putObject(objectKey(replayStart, "traces", "5", "binpb"), []byte(`not json`))
+
+	cfg := newTestConfig()
+	cfg.S3Downloader.Endpoint = server.URL
+	cfg.S3Downloader.S3ForcePathStyle = true
+	cfg.S3Downloader.DisableSSL = true
+
+	sink := new(consumertest.TracesSink)
+	r, err := newTracesReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, r.Shutdown(context.Background()))
+	}()
+
+	require.Eventually(t, func() bool {
+		return sink.SpanCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	var names []string
+	for _, td := range sink.AllTraces() {
+		names = append(names, td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+	}
+	assert.Equal(t, []string{"first", "second"}, names)
+}
+
+func TestReplayProtoMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+	buf, err := (&pmetric.ProtoMarshaler{}).MarshalMetrics(md)
+	require.NoError(t, err)
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	defer encoder.Close()
+
+	reader := &testS3Reader{objects: map[string][]byte{
+		objectKey(replayStart, "metrics", "1", "binpb.zst"): encoder.EncodeAll(buf, nil),
+		objectKey(replayStart, "metrics", "2", "binpb"):     buf,
+	}}
+	cfg := newTestConfig()
+	cfg.MarshalerName = OtlpProtobuf
+
+	sink := new(consumertest.MetricsSink)
+	r, err := newMetricsReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	startTestReceiver(t, r, reader)
+
+	require.Eventually(t, func() bool {
+		return sink.DataPointCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, md, sink.AllMetrics()[0])
+}
+
+func TestReplaySkipsInvalidObjects(t *testing.T) {
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("archived")
+	buf, err := (&plog.JSONMarshaler{}).MarshalLogs(ld)
+	require.NoError(t, err)
+
+	reader := &testS3Reader{objects: map[string][]byte{
+		objectKey(replayStart, "logs", "1", "json"):                  []byte(`{"resourceLogs":`),
+		objectKey(replayStart, "logs", "2", "json.gz"):               []byte(`not gzip`),
+		objectKey(replayStart, "logs", "3", "json"):                  []byte("\n"),
+		objectKey(replayStart.Add(time.Minute), "logs", "4", "json"): buf,
+	}}
+
+	sink := new(consumertest.LogsSink)
+	r, err := newLogsReceiver(receivertest.NewNopCreateSettings(), newTestConfig(), sink)
+	require.NoError(t, err)
+	startTestReceiver(t, r, reader)
+
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Len(t, sink.AllLogs(), 1)
+}
+
+func TestReplayRate(t *testing.T) {
+	reader := &testS3Reader{objects: map[string][]byte{
+		objectKey(replayStart, "traces", "1", "json"): marshalJSONTraces(t, "first"),
+		objectKey(replayStart, "traces", "2", "json"): marshalJSONTraces(t, "second"),
+		objectKey(replayStart, "traces", "3", "json"): marshalJSONTraces(t, "third"),
+	}}
+	cfg := newTestConfig()
+	cfg.MaxObjectsPerSecond = 20
+
+	sink := new(consumertest.TracesSink)
+	r, err := newTracesReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	started := time.Now()
+	startTestReceiver(t, r, reader)
+
+	require.Eventually(t, func() bool {
+		return sink.SpanCount() == 3
+	}, 5*time.Second, time.Millisecond)
+	// one object every 50ms
+	assert.GreaterOrEqual(t, time.Since(started), 150*time.Millisecond)
+}
+
+func TestShutdownStopsReplay(t *testing.T) {
+	reader := &testS3Reader{objects: map[string][]byte{
+		objectKey(replayStart, "traces", "1", "json"): marshalJSONTraces(t, "first"),
+	}}
+	cfg := newTestConfig()
+	cfg.MaxObjectsPerSecond = 0.001
+
+	r, err := newTracesReceiver(receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	r.newReader = func(S3DownloaderConfig) (s3Reader, error) {
+		return reader, nil
+	}
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.Empty(t, reader.readKeys())
+}
+
+func TestShutdownWithoutStart(t *testing.T) {
+	r, err := newTracesReceiver(receivertest.NewNopCreateSettings(), newTestConfig(), consumertest.NewNop())
+	require.NoError(t, err)
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
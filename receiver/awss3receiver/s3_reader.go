@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver"
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// s3Reader lists and reads the objects of the bucket.
+type s3Reader interface {
+	listObjects(ctx context.Context, prefix string) ([]string, error)
+	readObject(ctx context.Context, key string) ([]byte, error)
+}
+
+type awsS3Reader struct {
+	client *s3.S3
+	bucket string
+}
+
+func newAWSS3Reader(cfg S3DownloaderConfig) (*awsS3Reader, error) {
+	awsConfig := &aws.Config{
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+		DisableSSL:       aws.Bool(cfg.DisableSSL),
+	}
+	if cfg.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(cfg.Endpoint)
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &awsS3Reader{client: s3.New(sess), bucket: cfg.S3Bucket}, nil
+}
+
+func (r *awsS3Reader) listObjects(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	err := r.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(r.bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			keys = append(keys, aws.StringValue(object.Key))
+		}
+		return true
+	})
+	return keys, err
+}
+
+func (r *awsS3Reader) readObject(ctx context.Context, key string) ([]byte, error) {
+	output, err := r.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer output.Body.Close()
+	return io.ReadAll(output.Body)
+}
+
+// getTimeKey returns the part of the keys derived from the time, the same way the
+// awss3exporter does.
+func getTimeKey(time time.Time, partition string) string {
+	var timeKey string
+	year, month, day := time.Date()
+	hour, minute, _ := time.Clock()
+
+	if partition == "hour" {
+		timeKey = fmt.Sprintf("year=%d/month=%02d/day=%02d/hour=%02d", year, month, day, hour)
+	} else {
+		timeKey = fmt.Sprintf("year=%d/month=%02d/day=%02d/hour=%02d/minute=%02d", year, month, day, hour, minute)
+	}
+	return timeKey
+}
+
+// getS3Prefix returns the prefix of the keys of the objects written for the signal
+// in the partition holding the given time.
+func getS3Prefix(time time.Time, keyPrefix string, partition string, filePrefix string, signal string) string {
+	return keyPrefix + "/" + getTimeKey(time, partition) + "/" + filePrefix + signal + "_"
+}
+
+// partitions returns the start of every partition overlapping the window, in the
+// local time zone used by the exporter to name them.
+func partitions(start, end time.Time, partition string) []time.Time {
+	step := time.Minute
+	if partition == "hour" {
+		step = time.Hour
+	}
+	var starts []time.Time
+	for t := start.Local().Truncate(step); t.Before(end); t = t.Add(step) {
+		starts = append(starts, t)
+	}
+	return starts
+}
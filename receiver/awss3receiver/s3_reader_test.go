@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3Server stands in for S3, serving the listing and the content of in memory objects.
+type fakeS3Server struct {
+	*httptest.Server
+	bucket  string
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+type listBucketResult struct {
+	XMLName     xml.Name `xml:"ListBucketResult"`
+	Name        string   `xml:"Name"`
+	Prefix      string   `xml:"Prefix"`
+	KeyCount    int      `xml:"KeyCount"`
+	IsTruncated bool     `xml:"IsTruncated"`
+	Contents    []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+}
+
+func newFakeS3Server(t *testing.T, bucket string) *fakeS3Server {
+	s := &fakeS3Server{bucket: bucket, objects: map[string][]byte{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeS3Server) putObject(key string, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = content
+}
+
+func (s *fakeS3Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/"+s.bucket)
+	if r.Method != http.MethodGet || (path != "" && path != "/" && !strings.HasPrefix(path, "/")) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if path == "" || path == "/" {
+		prefix := r.URL.Query().Get("prefix")
+		result := listBucketResult{Name: s.bucket, Prefix: prefix}
+		var keys []string
+		for key := range s.objects {
+			if strings.HasPrefix(key, prefix) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			result.Contents = append(result.Contents, struct {
+				Key string `xml:"Key"`
+			}{Key: key})
+		}
+		result.KeyCount = len(keys)
+		w.Header().Set("Content-Type", "application/xml")
+		_ = xml.NewEncoder(w).Encode(result)
+		return
+	}
+	content, ok := s.objects[strings.TrimPrefix(path, "/")]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, _ = w.Write(content)
+}
+
+func (s *fakeS3Server) downloaderConfig() S3DownloaderConfig {
+	return S3DownloaderConfig{
+		Region:           "us-east-1",
+		S3Bucket:         s.bucket,
+		S3Partition:      "minute",
+		Endpoint:         s.URL,
+		S3ForcePathStyle: true,
+		DisableSSL:       true,
+	}
+}
+
+func TestAWSS3Reader(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	server := newFakeS3Server(t, "databucket")
+	server.putObject("logs/year=2023/month=05/day=04/hour=10/minute=00/logs_1.json", []byte("first"))
+	server.putObject("logs/year=2023/month=05/day=04/hour=10/minute=00/logs_2.json", []byte("second"))
+	server.putObject("logs/year=2023/month=05/day=04/hour=10/minute=01/logs_3.json", []byte("third"))
+
+	reader, err := newAWSS3Reader(server.downloaderConfig())
+	require.NoError(t, err)
+
+	keys, err := reader.listObjects(context.Background(), "logs/year=2023/month=05/day=04/hour=10/minute=00/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"logs/year=2023/month=05/day=04/hour=10/minute=00/logs_1.json",
+		"logs/year=2023/month=05/day=04/hour=10/minute=00/logs_2.json",
+	}, keys)
+
+	content, err := reader.readObject(context.Background(), keys[1])
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(content))
+
+	_, err = reader.readObject(context.Background(), "missing.json")
+	assert.Error(t, err)
+}
+
+func TestGetS3Prefix(t *testing.T) {
+	tm := time.Date(2022, 6, 5, 13, 7, 0, 0, time.UTC)
+	assert.Equal(t, "keyprefix/year=2022/month=06/day=05/hour=13/minute=07/fileprefixlogs_",
+		getS3Prefix(tm, "keyprefix", "minute", "fileprefix", "logs"))
+	assert.Equal(t, "/year=2022/month=06/day=05/hour=13/traces_",
+		getS3Prefix(tm, "", "hour", "", "traces"))
+}
+
+func TestPartitions(t *testing.T) {
+	start := time.Date(2023, 5, 4, 10, 0, 30, 0, time.UTC)
+	end := time.Date(2023, 5, 4, 10, 3, 0, 0, time.UTC)
+
+	minutes := partitions(start, end, "minute")
+	require.Len(t, minutes, 3)
+	for i, partition := range minutes {
+		assert.Equal(t, start.Truncate(time.Minute).Add(time.Duration(i)*time.Minute), partition.UTC())
+		assert.Equal(t, time.Local, partition.Location())
+	}
+
+	hours := partitions(start, end.Add(time.Hour), "hour")
+	require.Len(t, hours, 2)
+	assert.Equal(t, start.Truncate(time.Hour), hours[0].UTC())
+}
+
+func TestObjectFormat(t *testing.T) {
+	tests := []struct {
+		key         string
+		format      string
+		compression string
+	}{
+		{key: "a/logs_1.json", format: "json"},
+		{key: "a/logs_1.binpb.gz", format: "binpb", compression: "gzip"},
+		{key: "a/logs_1.json.zst", format: "json", compression: "zstd"},
+		{key: "a/logs_1", format: ""},
+	}
+	for _, tt := range tests {
+		format, compression := objectFormat(tt.key)
+		assert.Equal(t, tt.format, format, tt.key)
+		assert.Equal(t, tt.compression, compression, tt.key)
+	}
+}
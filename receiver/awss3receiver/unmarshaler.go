@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// fileFormat returns the extension of the objects written with the marshaler.
+func fileFormat(marshaler MarshalerType) string {
+	if marshaler == OtlpProtobuf {
+		return "binpb"
+	}
+	return "json"
+}
+
+// objectFormat returns the format of an object and the compression applied to it,
+// from the extensions of its key.
+func objectFormat(key string) (format string, compression string) {
+	switch {
+	case strings.HasSuffix(key, ".gz"):
+		compression = "gzip"
+		key = strings.TrimSuffix(key, ".gz")
+	case strings.HasSuffix(key, ".zst"):
+		compression = "zstd"
+		key = strings.TrimSuffix(key, ".zst")
+	}
+	return strings.TrimPrefix(path.Ext(key), "."), compression
+}
+
+// decompress returns the content of an object compressed by the exporter.
+func decompress(buf []byte, compression string) ([]byte, error) {
+	switch compression {
+	case "gzip":
+		reader, err := gzip.NewReader(bytes.NewReader(buf))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return io.ReadAll(reader)
+	case "zstd":
+		decoder, err := zstd.NewReader(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer decoder.Close()
+		return decoder.DecodeAll(buf, nil)
+	default:
+		return buf, nil
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -48,6 +49,10 @@ type Config struct {
 
 	Objects []*K8sObjectsConfig `mapstructure:"objects"`
 
+	// StorageID is the ID of a storage extension used to persist the last seen resource version
+	// of each watched object type and namespace, so that watches resume from it after a restart.
+	StorageID *component.ID `mapstructure:"storage"`
+
 	// For mocking purposes only.
 	makeDiscoveryClient func() (discovery.ServerResourcesInterface, error)
 	makeDynamicClient   func() (dynamic.Interface, error)
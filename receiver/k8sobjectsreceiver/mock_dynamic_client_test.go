@@ -5,13 +5,17 @@ package k8sobjectsreceiver
 
 import (
 	"context"
+	"net/http"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 type mockDynamicClient struct {
@@ -63,3 +67,44 @@ func generatePod(name, namespace string, labels map[string]interface{}) *unstruc
 	pod.SetResourceVersion("1")
 	return &pod
 }
+
+// watchedResourceVersions returns the resource versions the watches were started from.
+func (c mockDynamicClient) watchedResourceVersions() []string {
+	var resourceVersions []string
+	for _, action := range c.client.(*fake.FakeDynamicClient).Actions() {
+		if watchAction, ok := action.(k8stesting.WatchAction); ok {
+			resourceVersions = append(resourceVersions, watchAction.GetWatchRestrictions().ResourceVersion)
+		}
+	}
+	return resourceVersions
+}
+
+// expireWatchOnce makes the first watch fail because its resource version is too old.
+func (c mockDynamicClient) expireWatchOnce() {
+	expired := false
+	c.client.(*fake.FakeDynamicClient).PrependWatchReactor("pods", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		if expired {
+			return false, nil, nil
+		}
+		expired = true
+		watcher := watch.NewFake()
+		status := apierrors.NewResourceExpired("too old resource version").Status()
+		status.Code = http.StatusGone
+		go watcher.Error(&status)
+		return true, watcher, nil
+	})
+}
+
+// listPods makes the list of pods return the given objects and resource version.
+func (c mockDynamicClient) listPods(resourceVersion string, objects ...*unstructured.Unstructured) {
+	c.client.(*fake.FakeDynamicClient).PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		list := &unstructured.UnstructuredList{}
+		list.SetAPIVersion("v1")
+		list.SetKind("PodList")
+		list.SetResourceVersion(resourceVersion)
+		for _, object := range objects {
+			list.Items = append(list.Items, *object)
+		}
+		return true, list, nil
+	})
+}
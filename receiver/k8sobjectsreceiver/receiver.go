@@ -10,9 +10,12 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/obsreport"
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apiWatch "k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
@@ -22,6 +25,10 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sobjectsreceiver/internal/metadata"
 )
 
+// defaultFlushInterval is how often the last seen resource version of a watch is persisted,
+// so that busy watches do not write to the storage on every event.
+const defaultFlushInterval = 10 * time.Second
+
 type k8sobjectsreceiver struct {
 	setting         receiver.CreateSettings
 	objects         []*K8sObjectsConfig
@@ -29,7 +36,11 @@ type k8sobjectsreceiver struct {
 	client          dynamic.Interface
 	consumer        consumer.Logs
 	obsrecv         *obsreport.Receiver
+	storageID       *component.ID
+	storageClient   storage.Client
+	flushInterval   time.Duration
 	mu              sync.Mutex
+	watchers        sync.WaitGroup
 }
 
 func newReceiver(params receiver.CreateSettings, config *Config, consumer consumer.Logs) (receiver.Logs, error) {
@@ -49,31 +60,44 @@ func newReceiver(params receiver.CreateSettings, config *Config, consumer consum
 	}
 
 	return &k8sobjectsreceiver{
-		client:   client,
-		setting:  params,
-		consumer: consumer,
-		objects:  config.Objects,
-		obsrecv:  obsrecv,
-		mu:       sync.Mutex{},
+		client:        client,
+		setting:       params,
+		consumer:      consumer,
+		objects:       config.Objects,
+		obsrecv:       obsrecv,
+		storageID:     config.StorageID,
+		flushInterval: defaultFlushInterval,
+		mu:            sync.Mutex{},
 	}, nil
 }
 
 func (kr *k8sobjectsreceiver) Start(ctx context.Context, host component.Host) error {
 	kr.setting.Logger.Info("Object Receiver started")
 
+	storageClient, err := getStorageClient(ctx, host, kr.storageID, kr.setting.ID)
+	if err != nil {
+		return err
+	}
+	kr.storageClient = storageClient
+
 	for _, object := range kr.objects {
 		kr.start(ctx, object)
 	}
 	return nil
 }
 
-func (kr *k8sobjectsreceiver) Shutdown(context.Context) error {
+func (kr *k8sobjectsreceiver) Shutdown(ctx context.Context) error {
 	kr.setting.Logger.Info("Object Receiver stopped")
 	kr.mu.Lock()
 	for _, stopperChan := range kr.stopperChanList {
 		close(stopperChan)
 	}
 	kr.mu.Unlock()
+	// Wait for the watches to persist their last seen resource version.
+	kr.watchers.Wait()
+	if kr.storageClient != nil {
+		return kr.storageClient.Close(ctx)
+	}
 	return nil
 }
 
@@ -93,10 +117,10 @@ func (kr *k8sobjectsreceiver) start(ctx context.Context, object *K8sObjectsConfi
 
 	case WatchMode:
 		if len(object.Namespaces) == 0 {
-			go kr.startWatch(ctx, object, resource)
+			kr.goWatch(ctx, object, resource, "")
 		} else {
 			for _, ns := range object.Namespaces {
-				go kr.startWatch(ctx, object, resource.Namespace(ns))
+				kr.goWatch(ctx, object, resource.Namespace(ns), ns)
 			}
 		}
 	}
@@ -139,25 +163,72 @@ func (kr *k8sobjectsreceiver) startPull(ctx context.Context, config *K8sObjectsC
 
 }
 
-func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface) {
-
+// goWatch registers the stopper of the watch before starting it, so that Shutdown
+// can stop it and wait for it to return.
+func (kr *k8sobjectsreceiver) goWatch(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface, namespace string) {
 	stopperChan := make(chan struct{})
 	kr.mu.Lock()
 	kr.stopperChanList = append(kr.stopperChanList, stopperChan)
 	kr.mu.Unlock()
 
+	kr.watchers.Add(1)
+	go func() {
+		defer kr.watchers.Done()
+		kr.startWatch(ctx, config, resource, namespace, stopperChan)
+	}()
+}
+
+func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface, namespace string, stopperChan chan struct{}) {
+	key := resourceVersionKey(config.gvr, namespace)
+	resourceVersion := kr.loadResourceVersion(ctx, key, config.ResourceVersion)
+
+	for {
+		var gone bool
+		resourceVersion, gone = kr.doWatch(ctx, config, resource, key, resourceVersion, stopperChan)
+		if !gone {
+			return
+		}
+
+		kr.setting.Logger.Warn("Resource version is too old, relisting objects", zap.String("resource", config.gvr.String()), zap.String("resourceVersion", resourceVersion))
+		var err error
+		resourceVersion, err = kr.relist(ctx, config, resource, key, resourceVersion)
+		if err != nil {
+			kr.setting.Logger.Error("error in relisting object", zap.String("resource", config.gvr.String()), zap.Error(err))
+			return
+		}
+	}
+}
+
+// doWatch watches the objects starting from resourceVersion until the receiver is stopped or the watch
+// ends. It returns the last seen resource version and whether the watch ended because that resource
+// version is too old to resume from. The last seen resource version is persisted every flushInterval
+// when it changed, and once more when the watch ends.
+func (kr *k8sobjectsreceiver) doWatch(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface, key string, resourceVersion string, stopperChan chan struct{}) (string, bool) {
 	watchFunc := func(options metav1.ListOptions) (apiWatch.Interface, error) {
 		return resource.Watch(ctx, metav1.ListOptions{
-			FieldSelector: config.FieldSelector,
-			LabelSelector: config.LabelSelector,
+			FieldSelector:   config.FieldSelector,
+			LabelSelector:   config.LabelSelector,
+			ResourceVersion: options.ResourceVersion,
 		})
 	}
 
-	watch, err := watch.NewRetryWatcher(config.ResourceVersion, &cache.ListWatch{WatchFunc: watchFunc})
+	watch, err := watch.NewRetryWatcher(resourceVersion, &cache.ListWatch{WatchFunc: watchFunc})
 	if err != nil {
 		kr.setting.Logger.Error("error in watching object", zap.String("resource", config.gvr.String()), zap.Error(err))
-		return
+		return resourceVersion, false
+	}
+
+	storedResourceVersion := resourceVersion
+	flush := func() {
+		if resourceVersion != storedResourceVersion {
+			kr.storeResourceVersion(ctx, key, resourceVersion)
+			storedResourceVersion = resourceVersion
+		}
 	}
+	defer flush()
+
+	flushTicker := time.NewTicker(kr.flushInterval)
+	defer flushTicker.Stop()
 
 	res := watch.ResultChan()
 	for {
@@ -165,19 +236,86 @@ func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjects
 		case data, ok := <-res:
 			if !ok {
 				kr.setting.Logger.Warn("Watch channel closed unexpectedly", zap.String("resource", config.gvr.String()))
-				return
+				return resourceVersion, false
+			}
+			if data.Type == apiWatch.Error {
+				if err := apierrors.FromObject(data.Object); apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+					watch.Stop()
+					return resourceVersion, true
+				}
 			}
 			logs := watchObjectsToLogData(&data, time.Now(), config)
 
 			obsCtx := kr.obsrecv.StartLogsOp(ctx)
 			err := kr.consumer.ConsumeLogs(obsCtx, logs)
 			kr.obsrecv.EndLogsOp(obsCtx, metadata.Type, 1, err)
+
+			if accessor, err := meta.Accessor(data.Object); err == nil && accessor.GetResourceVersion() != "" {
+				resourceVersion = accessor.GetResourceVersion()
+			}
+		case <-flushTicker.C:
+			flush()
 		case <-stopperChan:
 			watch.Stop()
-			return
+			return resourceVersion, false
 		}
 	}
+}
+
+// relist lists the objects after the watch resource version expired, and reports the ones that changed
+// after lastResourceVersion as added, dropping the ones already reported by the watch. It returns the
+// resource version of the list to resume the watch from.
+func (kr *k8sobjectsreceiver) relist(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface, key string, lastResourceVersion string) (string, error) {
+	objects, err := resource.List(ctx, metav1.ListOptions{
+		FieldSelector: config.FieldSelector,
+		LabelSelector: config.LabelSelector,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	resourceVersion := objects.GetResourceVersion()
+	if resourceVersion == "" {
+		resourceVersion = lastResourceVersion
+	}
+	for i := range objects.Items {
+		object := &objects.Items[i]
+		if !isNewerResourceVersion(object.GetResourceVersion(), lastResourceVersion) {
+			continue
+		}
+		logs := watchObjectsToLogData(&apiWatch.Event{Type: apiWatch.Added, Object: object}, time.Now(), config)
 
+		obsCtx := kr.obsrecv.StartLogsOp(ctx)
+		err = kr.consumer.ConsumeLogs(obsCtx, logs)
+		kr.obsrecv.EndLogsOp(obsCtx, metadata.Type, 1, err)
+
+		if objects.GetResourceVersion() == "" && isNewerResourceVersion(object.GetResourceVersion(), resourceVersion) {
+			resourceVersion = object.GetResourceVersion()
+		}
+	}
+
+	kr.storeResourceVersion(ctx, key, resourceVersion)
+	return resourceVersion, nil
+}
+
+// loadResourceVersion returns the persisted resource version for key, or defaultResourceVersion if
+// there is none.
+func (kr *k8sobjectsreceiver) loadResourceVersion(ctx context.Context, key string, defaultResourceVersion string) string {
+	value, err := kr.storageClient.Get(ctx, key)
+	if err != nil {
+		kr.setting.Logger.Error("error in loading resource version", zap.String("key", key), zap.Error(err))
+		return defaultResourceVersion
+	}
+	if len(value) == 0 {
+		return defaultResourceVersion
+	}
+	return string(value)
+}
+
+func (kr *k8sobjectsreceiver) storeResourceVersion(ctx context.Context, key string, resourceVersion string) {
+	if err := kr.storageClient.Set(ctx, key, []byte(resourceVersion)); err != nil {
+		kr.setting.Logger.Error("error in storing resource version", zap.String("key", key), zap.Error(err))
+	}
 }
 
 // Start ticking immediately.
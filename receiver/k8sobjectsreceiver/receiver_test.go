@@ -5,14 +5,21 @@ package k8sobjectsreceiver
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 )
 
 func TestNewReceiver(t *testing.T) {
@@ -139,3 +146,270 @@ func TestWatchObject(t *testing.T) {
 
 	assert.NoError(t, r.Shutdown(ctx))
 }
+
+func TestWatchObjectPersistsResourceVersion(t *testing.T) {
+	t.Parallel()
+
+	mockClient := newMockDynamicClient()
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.makeDynamicClient = mockClient.getMockDynamicClient
+	rCfg.makeDiscoveryClient = getMockDiscoveryClient
+
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:       "pods",
+			Mode:       WatchMode,
+			Namespaces: []string{"default"},
+		},
+	}
+
+	err := rCfg.Validate()
+	require.NoError(t, err)
+
+	storageExt := storagetest.NewFileBackedStorageExtension("test", t.TempDir())
+	rCfg.StorageID = &storageExt.ID
+	host := storagetest.NewStorageHost().WithExtension(storageExt.ID, storageExt)
+
+	set := receivertest.NewNopCreateSettings()
+	consumer := newMockLogConsumer()
+	r, err := newReceiver(set, rCfg, consumer)
+
+	ctx := context.Background()
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	require.NoError(t, r.Start(ctx, host))
+
+	time.Sleep(time.Millisecond * 100)
+
+	pod := generatePod("pod1", "default", map[string]interface{}{
+		"environment": "production",
+	})
+	pod.SetResourceVersion("15")
+	mockClient.createPods(pod)
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, 1, consumer.Count())
+	assert.NoError(t, r.Shutdown(ctx))
+
+	assert.Equal(t, "15", getStoredResourceVersion(t, storageExt, set.ID, "default"))
+}
+
+func TestWatchObjectFlushesResourceVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		flushInterval time.Duration
+		// flushed is whether the resource version is expected to be persisted before shutdown
+		flushed bool
+	}{
+		{
+			name:          "on shutdown",
+			flushInterval: time.Hour,
+		},
+		{
+			name:          "periodically",
+			flushInterval: 10 * time.Millisecond,
+			flushed:       true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockClient := newMockDynamicClient()
+
+			rCfg := createDefaultConfig().(*Config)
+			rCfg.makeDynamicClient = mockClient.getMockDynamicClient
+			rCfg.makeDiscoveryClient = getMockDiscoveryClient
+
+			rCfg.Objects = []*K8sObjectsConfig{
+				{
+					Name:       "pods",
+					Mode:       WatchMode,
+					Namespaces: []string{"default"},
+				},
+			}
+
+			err := rCfg.Validate()
+			require.NoError(t, err)
+
+			storageExt := &countingStorage{TestStorage: storagetest.NewFileBackedStorageExtension("test", t.TempDir())}
+			rCfg.StorageID = &storageExt.ID
+			host := storagetest.NewStorageHost().WithExtension(storageExt.ID, storageExt)
+
+			set := receivertest.NewNopCreateSettings()
+			consumer := newMockLogConsumer()
+			r, err := newReceiver(set, rCfg, consumer)
+			require.NoError(t, err)
+			r.(*k8sobjectsreceiver).flushInterval = tt.flushInterval
+
+			ctx := context.Background()
+			require.NoError(t, r.Start(ctx, host))
+
+			time.Sleep(time.Millisecond * 100)
+
+			mockClient.createPods(
+				withResourceVersion(generatePod("pod1", "default", map[string]interface{}{}), "15"),
+				withResourceVersion(generatePod("pod2", "default", map[string]interface{}{}), "16"),
+				withResourceVersion(generatePod("pod3", "default", map[string]interface{}{}), "17"),
+			)
+
+			time.Sleep(time.Millisecond * 100)
+			assert.Equal(t, 3, consumer.Count())
+			sets := storageExt.sets.Load()
+			assert.Less(t, sets, int64(3), "Must not persist the resource version on every event")
+			if tt.flushed {
+				assert.Positive(t, sets, "Must persist the resource version periodically")
+			} else {
+				assert.Zero(t, sets)
+				sets++
+			}
+
+			assert.NoError(t, r.Shutdown(ctx))
+			assert.Equal(t, sets, storageExt.sets.Load(), "Must only persist the resource version when it changed")
+			assert.Equal(t, "17", getStoredResourceVersion(t, storageExt.TestStorage, set.ID, "default"))
+		})
+	}
+}
+
+func TestWatchObjectResumesFromStorage(t *testing.T) {
+	t.Parallel()
+
+	mockClient := newMockDynamicClient()
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.makeDynamicClient = mockClient.getMockDynamicClient
+	rCfg.makeDiscoveryClient = getMockDiscoveryClient
+
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:       "pods",
+			Mode:       WatchMode,
+			Namespaces: []string{"default"},
+		},
+	}
+
+	err := rCfg.Validate()
+	require.NoError(t, err)
+
+	storageExt := storagetest.NewFileBackedStorageExtension("test", t.TempDir())
+	rCfg.StorageID = &storageExt.ID
+	host := storagetest.NewStorageHost().WithExtension(storageExt.ID, storageExt)
+
+	set := receivertest.NewNopCreateSettings()
+	setStoredResourceVersion(t, storageExt, set.ID, "default", "42")
+
+	r, err := newReceiver(set, rCfg, newMockLogConsumer())
+
+	ctx := context.Background()
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	require.NoError(t, r.Start(ctx, host))
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, []string{"42"}, mockClient.watchedResourceVersions())
+
+	assert.NoError(t, r.Shutdown(ctx))
+}
+
+func TestWatchObjectRelistsWhenResourceVersionIsGone(t *testing.T) {
+	t.Parallel()
+
+	mockClient := newMockDynamicClient()
+	mockClient.expireWatchOnce()
+	mockClient.listPods("20",
+		withResourceVersion(generatePod("pod1", "default", map[string]interface{}{}), "3"),
+		withResourceVersion(generatePod("pod2", "default", map[string]interface{}{}), "10"),
+	)
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.makeDynamicClient = mockClient.getMockDynamicClient
+	rCfg.makeDiscoveryClient = getMockDiscoveryClient
+
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:       "pods",
+			Mode:       WatchMode,
+			Namespaces: []string{"default"},
+		},
+	}
+
+	err := rCfg.Validate()
+	require.NoError(t, err)
+
+	storageExt := storagetest.NewFileBackedStorageExtension("test", t.TempDir())
+	rCfg.StorageID = &storageExt.ID
+	host := storagetest.NewStorageHost().WithExtension(storageExt.ID, storageExt)
+
+	set := receivertest.NewNopCreateSettings()
+	setStoredResourceVersion(t, storageExt, set.ID, "default", "5")
+
+	consumer := newMockLogConsumer()
+	r, err := newReceiver(set, rCfg, consumer)
+
+	ctx := context.Background()
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	require.NoError(t, r.Start(ctx, host))
+
+	time.Sleep(time.Millisecond * 100)
+
+	// pod1 was already seen before the resource version expired, only pod2 is reported.
+	assert.Equal(t, 1, consumer.Count())
+	assert.Equal(t, []string{"5", "20"}, mockClient.watchedResourceVersions())
+
+	assert.NoError(t, r.Shutdown(ctx))
+
+	assert.Equal(t, "20", getStoredResourceVersion(t, storageExt, set.ID, "default"))
+}
+
+func getStoredResourceVersion(t *testing.T, storageExt *storagetest.TestStorage, id component.ID, namespace string) string {
+	client, err := storageExt.GetClient(context.Background(), component.KindReceiver, id, "")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, client.Close(context.Background())) }()
+
+	value, err := client.Get(context.Background(), resourceVersionKey(&schema.GroupVersionResource{Version: "v1", Resource: "pods"}, namespace))
+	require.NoError(t, err)
+	return string(value)
+}
+
+func setStoredResourceVersion(t *testing.T, storageExt *storagetest.TestStorage, id component.ID, namespace string, resourceVersion string) {
+	client, err := storageExt.GetClient(context.Background(), component.KindReceiver, id, "")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, client.Close(context.Background())) }()
+
+	key := resourceVersionKey(&schema.GroupVersionResource{Version: "v1", Resource: "pods"}, namespace)
+	require.NoError(t, client.Set(context.Background(), key, []byte(resourceVersion)))
+}
+
+func withResourceVersion(object *unstructured.Unstructured, resourceVersion string) *unstructured.Unstructured {
+	object.SetResourceVersion(resourceVersion)
+	return object
+}
+
+// countingStorage counts the values set by the clients of the storage extension.
+type countingStorage struct {
+	*storagetest.TestStorage
+	sets atomic.Int64
+}
+
+func (s *countingStorage) GetClient(ctx context.Context, kind component.Kind, id component.ID, name string) (storage.Client, error) {
+	client, err := s.TestStorage.GetClient(ctx, kind, id, name)
+	if err != nil {
+		return nil, err
+	}
+	return &countingClient{Client: client, sets: &s.sets}, nil
+}
+
+type countingClient struct {
+	storage.Client
+	sets *atomic.Int64
+}
+
+func (c *countingClient) Set(ctx context.Context, key string, value []byte) error {
+	c.sets.Add(1)
+	return c.Client.Set(ctx, key, value)
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sobjectsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sobjectsreceiver"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func getStorageClient(ctx context.Context, host component.Host, storageID *component.ID, componentID component.ID) (storage.Client, error) {
+	if storageID == nil {
+		return storage.NewNopClient(), nil
+	}
+
+	extension, ok := host.GetExtensions()[*storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindReceiver, componentID, "")
+}
+
+// resourceVersionKey returns the storage key of the last seen resource version for the given
+// object type and namespace. The namespace is empty when watching all namespaces.
+func resourceVersionKey(gvr *schema.GroupVersionResource, namespace string) string {
+	return fmt.Sprintf("resourceVersion/%s/%s/%s/%s", gvr.Group, gvr.Version, gvr.Resource, namespace)
+}
+
+// isNewerResourceVersion reports whether resourceVersion is more recent than lastResourceVersion.
+// Resource versions are opaque strings, but the API server backs them with etcd revisions, so
+// they are compared numerically when possible. Anything that cannot be compared is considered newer,
+// so that objects are never dropped.
+func isNewerResourceVersion(resourceVersion, lastResourceVersion string) bool {
+	rv, err := strconv.ParseUint(resourceVersion, 10, 64)
+	if err != nil {
+		return true
+	}
+	lastRV, err := strconv.ParseUint(lastResourceVersion, 10, 64)
+	if err != nil {
+		return true
+	}
+	return rv > lastRV
+}
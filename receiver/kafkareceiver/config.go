@@ -4,6 +4,9 @@
 package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -39,6 +42,15 @@ type Config struct {
 	ProtocolVersion string `mapstructure:"protocol_version"`
 	// The name of the kafka topic to consume from (default "otlp_spans")
 	Topic string `mapstructure:"topic"`
+	// The names of the kafka topics to consume from. When set, Topic is ignored.
+	Topics []string `mapstructure:"topics"`
+	// A regular expression matching the names of the kafka topics to consume from.
+	// When set, Topic is ignored.
+	TopicRegex string `mapstructure:"topic_regex"`
+	// How often the topics matching TopicRegex are rediscovered (default 1m)
+	TopicRefreshInterval time.Duration `mapstructure:"topic_refresh_interval"`
+	// Whether to add the topic, partition and offset of the messages to the resource attributes
+	AddSourceAttributes bool `mapstructure:"add_source_attributes"`
 	// Encoding of the messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
 	// The consumer group that receiver will be consuming messages from (default "otel-collector")
@@ -60,6 +72,17 @@ type Config struct {
 
 	// Controls the way the messages are marked as consumed
 	MessageMarking MessageMarking `mapstructure:"message_marking"`
+
+	// Controls which message headers are added to the resource attributes
+	HeaderExtraction HeaderExtraction `mapstructure:"header_extraction"`
+}
+
+type HeaderExtraction struct {
+	// Whether to add the message headers to the resource attributes
+	ExtractHeaders bool `mapstructure:"extract_headers"`
+
+	// The names of the headers to add, as `kafka.header.<name>` attributes
+	Headers []string `mapstructure:"headers"`
 }
 
 const (
@@ -71,5 +94,24 @@ var _ component.Config = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	if len(cfg.Topics) > 0 && cfg.TopicRegex != "" {
+		return errors.New("topics and topic_regex can't be set at the same time")
+	}
+	for _, topic := range cfg.Topics {
+		if topic == "" {
+			return errors.New("topics must not contain empty topic names")
+		}
+	}
+	if cfg.HeaderExtraction.ExtractHeaders && len(cfg.HeaderExtraction.Headers) == 0 {
+		return errors.New("header_extraction.headers must not be empty when extract_headers is enabled")
+	}
+	if cfg.TopicRegex != "" {
+		if _, err := regexp.Compile(cfg.TopicRegex); err != nil {
+			return fmt.Errorf("invalid topic_regex: %w", err)
+		}
+		if cfg.TopicRefreshInterval <= 0 {
+			return errors.New("topic_refresh_interval must be positive when topic_regex is set")
+		}
+	}
 	return nil
 }
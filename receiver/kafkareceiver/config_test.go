@@ -4,6 +4,7 @@
 package kafkareceiver
 
 import (
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
@@ -32,12 +33,13 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, ""),
 			expected: &Config{
-				Topic:         "spans",
-				Encoding:      "otlp_proto",
-				Brokers:       []string{"foo:123", "bar:456"},
-				ClientID:      "otel-collector",
-				GroupID:       "otel-collector",
-				InitialOffset: "latest",
+				Topic:                "spans",
+				TopicRefreshInterval: time.Minute,
+				Encoding:             "otlp_proto",
+				Brokers:              []string{"foo:123", "bar:456"},
+				ClientID:             "otel-collector",
+				GroupID:              "otel-collector",
+				InitialOffset:        "latest",
 				Authentication: kafkaexporter.Authentication{
 					TLS: &configtls.TLSClientSetting{
 						TLSSetting: configtls.TLSSetting{
@@ -64,12 +66,13 @@ func TestLoadConfig(t *testing.T) {
 
 			id: component.NewIDWithName(metadata.Type, "logs"),
 			expected: &Config{
-				Topic:         "logs",
-				Encoding:      "direct",
-				Brokers:       []string{"coffee:123", "foobar:456"},
-				ClientID:      "otel-collector",
-				GroupID:       "otel-collector",
-				InitialOffset: "earliest",
+				Topic:                "logs",
+				TopicRefreshInterval: time.Minute,
+				Encoding:             "direct",
+				Brokers:              []string{"coffee:123", "foobar:456"},
+				ClientID:             "otel-collector",
+				GroupID:              "otel-collector",
+				InitialOffset:        "earliest",
 				Authentication: kafkaexporter.Authentication{
 					TLS: &configtls.TLSClientSetting{
 						TLSSetting: configtls.TLSSetting{
@@ -92,6 +95,47 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "topics"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Topics = []string{"spans_a", "spans_b"}
+				cfg.AddSourceAttributes = true
+				return cfg
+			}(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "regex"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.TopicRegex = "^otlp_.*"
+				cfg.TopicRefreshInterval = 30 * time.Second
+				return cfg
+			}(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "headers"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.HeaderExtraction = HeaderExtraction{
+					ExtractHeaders: true,
+					Headers:        []string{"tenant"},
+				}
+				return cfg
+			}(),
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "headers_empty"),
+			expectedErr: errors.New("header_extraction.headers must not be empty when extract_headers is enabled"),
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_regex"),
+			expectedErr: errors.New("invalid topic_regex: error parsing regexp: missing closing ): `otlp_(`"),
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "topics_and_regex"),
+			expectedErr: errors.New("topics and topic_regex can't be set at the same time"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -103,6 +147,10 @@ func TestLoadConfig(t *testing.T) {
 			require.NoError(t, err)
 			require.NoError(t, component.UnmarshalConfig(sub, cfg))
 
+			if tt.expectedErr != nil {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr.Error())
+				return
+			}
 			assert.NoError(t, component.ValidateConfig(cfg))
 			assert.Equal(t, tt.expected, cfg)
 		})
@@ -16,6 +16,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/obsreport"
 	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
@@ -33,6 +34,7 @@ type kafkaTracesConsumer struct {
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Traces
 	topics            []string
+	topicMatcher      *topicMatcher
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       TracesUnmarshaler
 
@@ -40,6 +42,7 @@ type kafkaTracesConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	messageAttributes messageAttributes
 }
 
 // kafkaMetricsConsumer uses sarama to consume and handle messages from kafka.
@@ -47,6 +50,7 @@ type kafkaMetricsConsumer struct {
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Metrics
 	topics            []string
+	topicMatcher      *topicMatcher
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       MetricsUnmarshaler
 
@@ -54,6 +58,7 @@ type kafkaMetricsConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	messageAttributes messageAttributes
 }
 
 // kafkaLogsConsumer uses sarama to consume and handle messages from kafka.
@@ -61,6 +66,7 @@ type kafkaLogsConsumer struct {
 	consumerGroup     sarama.ConsumerGroup
 	nextConsumer      consumer.Logs
 	topics            []string
+	topicMatcher      *topicMatcher
 	cancelConsumeLoop context.CancelFunc
 	unmarshaler       LogsUnmarshaler
 
@@ -68,6 +74,7 @@ type kafkaLogsConsumer struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	messageAttributes messageAttributes
 }
 
 var _ receiver.Traces = (*kafkaTracesConsumer)(nil)
@@ -102,18 +109,20 @@ func newTracesReceiver(config Config, set receiver.CreateSettings, unmarshalers
 	if err := kafkaexporter.ConfigureAuthentication(config.Authentication, c); err != nil {
 		return nil, err
 	}
-	client, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
+	client, matcher, err := newConsumerGroup(config, c, set.Logger)
 	if err != nil {
 		return nil, err
 	}
 	return &kafkaTracesConsumer{
 		consumerGroup:     client,
-		topics:            []string{config.Topic},
+		topics:            consumeTopics(config),
+		topicMatcher:      matcher,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		messageAttributes: newMessageAttributes(config),
 	}, nil
 }
 
@@ -136,6 +145,7 @@ func (c *kafkaTracesConsumer) Start(_ context.Context, host component.Host) erro
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		messageAttributes: c.messageAttributes,
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, consumerGroup); err != nil {
@@ -151,7 +161,7 @@ func (c *kafkaTracesConsumer) consumeLoop(ctx context.Context, handler sarama.Co
 		// `Consume` should be called inside an infinite loop, when a
 		// server-side rebalance happens, the consumer session will need to be
 		// recreated to get the new claims
-		if err := c.consumerGroup.Consume(ctx, c.topics, handler); err != nil {
+		if err := c.consume(ctx, handler); err != nil {
 			c.settings.Logger.Error("Error from consumer", zap.Error(err))
 		}
 		// check if context was cancelled, signaling that the consumer should stop
@@ -162,9 +172,20 @@ func (c *kafkaTracesConsumer) consumeLoop(ctx context.Context, handler sarama.Co
 	}
 }
 
+func (c *kafkaTracesConsumer) consume(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
+	if c.topicMatcher != nil {
+		return c.topicMatcher.consume(ctx, c.consumerGroup, handler)
+	}
+	return c.consumerGroup.Consume(ctx, c.topics, handler)
+}
+
 func (c *kafkaTracesConsumer) Shutdown(context.Context) error {
 	c.cancelConsumeLoop()
-	return c.consumerGroup.Close()
+	err := c.consumerGroup.Close()
+	if c.topicMatcher != nil {
+		err = multierr.Append(err, c.topicMatcher.close())
+	}
+	return err
 }
 
 func newMetricsReceiver(config Config, set receiver.CreateSettings, unmarshalers map[string]MetricsUnmarshaler, nextConsumer consumer.Metrics) (*kafkaMetricsConsumer, error) {
@@ -195,18 +216,20 @@ func newMetricsReceiver(config Config, set receiver.CreateSettings, unmarshalers
 	if err := kafkaexporter.ConfigureAuthentication(config.Authentication, c); err != nil {
 		return nil, err
 	}
-	client, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
+	client, matcher, err := newConsumerGroup(config, c, set.Logger)
 	if err != nil {
 		return nil, err
 	}
 	return &kafkaMetricsConsumer{
 		consumerGroup:     client,
-		topics:            []string{config.Topic},
+		topics:            consumeTopics(config),
+		topicMatcher:      matcher,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		messageAttributes: newMessageAttributes(config),
 	}, nil
 }
 
@@ -229,6 +252,7 @@ func (c *kafkaMetricsConsumer) Start(_ context.Context, host component.Host) err
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		messageAttributes: c.messageAttributes,
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, metricsConsumerGroup); err != nil {
@@ -244,7 +268,7 @@ func (c *kafkaMetricsConsumer) consumeLoop(ctx context.Context, handler sarama.C
 		// `Consume` should be called inside an infinite loop, when a
 		// server-side rebalance happens, the consumer session will need to be
 		// recreated to get the new claims
-		if err := c.consumerGroup.Consume(ctx, c.topics, handler); err != nil {
+		if err := c.consume(ctx, handler); err != nil {
 			c.settings.Logger.Error("Error from consumer", zap.Error(err))
 		}
 		// check if context was cancelled, signaling that the consumer should stop
@@ -255,9 +279,20 @@ func (c *kafkaMetricsConsumer) consumeLoop(ctx context.Context, handler sarama.C
 	}
 }
 
+func (c *kafkaMetricsConsumer) consume(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
+	if c.topicMatcher != nil {
+		return c.topicMatcher.consume(ctx, c.consumerGroup, handler)
+	}
+	return c.consumerGroup.Consume(ctx, c.topics, handler)
+}
+
 func (c *kafkaMetricsConsumer) Shutdown(context.Context) error {
 	c.cancelConsumeLoop()
-	return c.consumerGroup.Close()
+	err := c.consumerGroup.Close()
+	if c.topicMatcher != nil {
+		err = multierr.Append(err, c.topicMatcher.close())
+	}
+	return err
 }
 
 func newLogsReceiver(config Config, set receiver.CreateSettings, unmarshalers map[string]LogsUnmarshaler, nextConsumer consumer.Logs) (*kafkaLogsConsumer, error) {
@@ -288,18 +323,20 @@ func newLogsReceiver(config Config, set receiver.CreateSettings, unmarshalers ma
 	if err = kafkaexporter.ConfigureAuthentication(config.Authentication, c); err != nil {
 		return nil, err
 	}
-	client, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
+	client, matcher, err := newConsumerGroup(config, c, set.Logger)
 	if err != nil {
 		return nil, err
 	}
 	return &kafkaLogsConsumer{
 		consumerGroup:     client,
-		topics:            []string{config.Topic},
+		topics:            consumeTopics(config),
+		topicMatcher:      matcher,
 		nextConsumer:      nextConsumer,
 		unmarshaler:       unmarshaler,
 		settings:          set,
 		autocommitEnabled: config.AutoCommit.Enable,
 		messageMarking:    config.MessageMarking,
+		messageAttributes: newMessageAttributes(config),
 	}, nil
 }
 
@@ -350,6 +387,7 @@ func (c *kafkaLogsConsumer) Start(_ context.Context, host component.Host) error
 		obsrecv:           obsrecv,
 		autocommitEnabled: c.autocommitEnabled,
 		messageMarking:    c.messageMarking,
+		messageAttributes: c.messageAttributes,
 	}
 	go func() {
 		if err := c.consumeLoop(ctx, logsConsumerGroup); err != nil {
@@ -365,7 +403,7 @@ func (c *kafkaLogsConsumer) consumeLoop(ctx context.Context, handler sarama.Cons
 		// `Consume` should be called inside an infinite loop, when a
 		// server-side rebalance happens, the consumer session will need to be
 		// recreated to get the new claims
-		if err := c.consumerGroup.Consume(ctx, c.topics, handler); err != nil {
+		if err := c.consume(ctx, handler); err != nil {
 			c.settings.Logger.Error("Error from consumer", zap.Error(err))
 		}
 		// check if context was cancelled, signaling that the consumer should stop
@@ -376,9 +414,20 @@ func (c *kafkaLogsConsumer) consumeLoop(ctx context.Context, handler sarama.Cons
 	}
 }
 
+func (c *kafkaLogsConsumer) consume(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
+	if c.topicMatcher != nil {
+		return c.topicMatcher.consume(ctx, c.consumerGroup, handler)
+	}
+	return c.consumerGroup.Consume(ctx, c.topics, handler)
+}
+
 func (c *kafkaLogsConsumer) Shutdown(context.Context) error {
 	c.cancelConsumeLoop()
-	return c.consumerGroup.Close()
+	err := c.consumerGroup.Close()
+	if c.topicMatcher != nil {
+		err = multierr.Append(err, c.topicMatcher.close())
+	}
+	return err
 }
 
 type tracesConsumerGroupHandler struct {
@@ -394,6 +443,7 @@ type tracesConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	messageAttributes messageAttributes
 }
 
 type metricsConsumerGroupHandler struct {
@@ -409,6 +459,7 @@ type metricsConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	messageAttributes messageAttributes
 }
 
 type logsConsumerGroupHandler struct {
@@ -424,6 +475,7 @@ type logsConsumerGroupHandler struct {
 
 	autocommitEnabled bool
 	messageMarking    MessageMarking
+	messageAttributes messageAttributes
 }
 
 var _ sarama.ConsumerGroupHandler = (*tracesConsumerGroupHandler)(nil)
@@ -480,6 +532,12 @@ func (c *tracesConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSe
 				return err
 			}
 
+			if c.messageAttributes.enabled() {
+				for i := 0; i < traces.ResourceSpans().Len(); i++ {
+					c.messageAttributes.apply(traces.ResourceSpans().At(i).Resource().Attributes(), message)
+				}
+			}
+
 			spanCount := traces.SpanCount()
 			err = c.nextConsumer.ConsumeTraces(session.Context(), traces)
 			c.obsrecv.EndTracesOp(ctx, c.unmarshaler.Encoding(), spanCount, err)
@@ -555,6 +613,12 @@ func (c *metricsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupS
 				return err
 			}
 
+			if c.messageAttributes.enabled() {
+				for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+					c.messageAttributes.apply(metrics.ResourceMetrics().At(i).Resource().Attributes(), message)
+				}
+			}
+
 			dataPointCount := metrics.DataPointCount()
 			err = c.nextConsumer.ConsumeMetrics(session.Context(), metrics)
 			c.obsrecv.EndMetricsOp(ctx, c.unmarshaler.Encoding(), dataPointCount, err)
@@ -635,6 +699,12 @@ func (c *logsConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSess
 				return err
 			}
 
+			if c.messageAttributes.enabled() {
+				for i := 0; i < logs.ResourceLogs().Len(); i++ {
+					c.messageAttributes.apply(logs.ResourceLogs().At(i).Resource().Attributes(), message)
+				}
+			}
+
 			err = c.nextConsumer.ConsumeLogs(session.Context(), logs)
 			// TODO
 			c.obsrecv.EndLogsOp(ctx, c.unmarshaler.Encoding(), logs.LogRecordCount(), err)
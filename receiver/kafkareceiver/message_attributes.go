@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	conventions "go.opentelemetry.io/collector/semconv/v1.18.0"
+)
+
+const headerAttributePrefix = "kafka.header."
+
+// messageAttributes adds details of the kafka messages to the resources of the data they hold.
+type messageAttributes struct {
+	// source adds the topic, partition and offset of the message
+	source bool
+	// headers are the names of the message headers to add
+	headers []string
+}
+
+func newMessageAttributes(config Config) messageAttributes {
+	attrs := messageAttributes{source: config.AddSourceAttributes}
+	if config.HeaderExtraction.ExtractHeaders {
+		attrs.headers = config.HeaderExtraction.Headers
+	}
+	return attrs
+}
+
+func (a messageAttributes) enabled() bool {
+	return a.source || len(a.headers) > 0
+}
+
+func (a messageAttributes) apply(attrs pcommon.Map, message *sarama.ConsumerMessage) {
+	if a.source {
+		attrs.PutStr(conventions.AttributeMessagingSourceName, message.Topic)
+		attrs.PutInt(conventions.AttributeMessagingKafkaSourcePartition, int64(message.Partition))
+		attrs.PutInt(conventions.AttributeMessagingKafkaMessageOffset, message.Offset)
+	}
+	for _, name := range a.headers {
+		for _, header := range message.Headers {
+			if header != nil && string(header.Key) == name {
+				attrs.PutStr(headerAttributePrefix+name, string(header.Value))
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkareceiver
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+)
+
+func TestNewMessageAttributes(t *testing.T) {
+	assert.False(t, newMessageAttributes(Config{}).enabled())
+	assert.False(t, newMessageAttributes(Config{HeaderExtraction: HeaderExtraction{Headers: []string{"tenant"}}}).enabled())
+	assert.True(t, newMessageAttributes(Config{AddSourceAttributes: true}).enabled())
+
+	attrs := newMessageAttributes(Config{HeaderExtraction: HeaderExtraction{ExtractHeaders: true, Headers: []string{"tenant"}}})
+	assert.True(t, attrs.enabled())
+	assert.Equal(t, []string{"tenant"}, attrs.headers)
+}
+
+func TestMessageAttributesApply(t *testing.T) {
+	message := &sarama.ConsumerMessage{
+		Topic:     "otlp_spans",
+		Partition: 3,
+		Offset:    42,
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("tenant"), Value: []byte("acme")},
+			{Key: []byte("tenant"), Value: []byte("ignored")},
+			{Key: []byte("other"), Value: []byte("value")},
+		},
+	}
+
+	attrs := pcommon.NewMap()
+	messageAttributes{headers: []string{"tenant", "missing"}}.apply(attrs, message)
+	assert.Equal(t, map[string]any{"kafka.header.tenant": "acme"}, attrs.AsRaw())
+
+	attrs = pcommon.NewMap()
+	messageAttributes{source: true}.apply(attrs, message)
+	assert.Equal(t, map[string]any{
+		"messaging.source.name":            "otlp_spans",
+		"messaging.kafka.source.partition": int64(3),
+		"messaging.kafka.message.offset":   int64(42),
+	}, attrs.AsRaw())
+}
+
+func TestTracesConsumerGroupHandlerMessageAttributes(t *testing.T) {
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{ReceiverCreateSettings: receivertest.NewNopCreateSettings()})
+	require.NoError(t, err)
+	sink := new(consumertest.TracesSink)
+	c := tracesConsumerGroupHandler{
+		unmarshaler:       newPdataTracesUnmarshaler(&ptrace.ProtoUnmarshaler{}, defaultEncoding),
+		logger:            zap.NewNop(),
+		ready:             make(chan bool),
+		nextConsumer:      sink,
+		obsrecv:           obsrecv,
+		messageAttributes: messageAttributes{source: true, headers: []string{"tenant"}},
+	}
+
+	payload, err := (&ptrace.ProtoMarshaler{}).MarshalTraces(testdata.GenerateTracesOneSpan())
+	require.NoError(t, err)
+	groupClaim := testConsumerGroupClaim{
+		messageChan: make(chan *sarama.ConsumerMessage),
+	}
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		require.NoError(t, c.ConsumeClaim(testConsumerGroupSession{ctx: context.Background()}, groupClaim))
+		wg.Done()
+	}()
+	groupClaim.messageChan <- &sarama.ConsumerMessage{
+		Topic:     "otlp_spans",
+		Partition: 3,
+		Offset:    42,
+		Value:     payload,
+		Headers:   []*sarama.RecordHeader{{Key: []byte("tenant"), Value: []byte("acme")}},
+	}
+	close(groupClaim.messageChan)
+	wg.Wait()
+
+	require.Len(t, sink.AllTraces(), 1)
+	attrs := sink.AllTraces()[0].ResourceSpans().At(0).Resource().Attributes().AsRaw()
+	assert.Equal(t, "otlp_spans", attrs["messaging.source.name"])
+	assert.Equal(t, int64(3), attrs["messaging.kafka.source.partition"])
+	assert.Equal(t, int64(42), attrs["messaging.kafka.message.offset"])
+	assert.Equal(t, "acme", attrs["kafka.header.tenant"])
+}
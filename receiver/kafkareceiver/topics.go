@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkareceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkareceiver"
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+)
+
+// topicLister lists the topics of the kafka cluster, it's implemented by sarama.Client.
+type topicLister interface {
+	RefreshMetadata(topics ...string) error
+	Topics() ([]string, error)
+	Close() error
+}
+
+// topicMatcher discovers the topics matching a regular expression.
+type topicMatcher struct {
+	client          topicLister
+	pattern         *regexp.Regexp
+	refreshInterval time.Duration
+	logger          *zap.Logger
+}
+
+// matchingTopics returns the sorted list of the topics currently matching the pattern.
+func (m *topicMatcher) matchingTopics() ([]string, error) {
+	if err := m.client.RefreshMetadata(); err != nil {
+		return nil, err
+	}
+	all, err := m.client.Topics()
+	if err != nil {
+		return nil, err
+	}
+	var topics []string
+	for _, topic := range all {
+		if m.pattern.MatchString(topic) {
+			topics = append(topics, topic)
+		}
+	}
+	sort.Strings(topics)
+	return topics, nil
+}
+
+// consume runs a single consumer group session over the matching topics. The session
+// is ended as soon as the set of matching topics changes, so that the next one picks
+// up the new topics.
+func (m *topicMatcher) consume(ctx context.Context, group sarama.ConsumerGroup, handler sarama.ConsumerGroupHandler) error {
+	topics, err := m.matchingTopics()
+	if err != nil || len(topics) == 0 {
+		if err != nil {
+			m.logger.Error("Failed to list the kafka topics", zap.Error(err))
+		} else {
+			m.logger.Warn("No kafka topic matches the topic regex", zap.String("topic_regex", m.pattern.String()))
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(m.refreshInterval):
+		}
+		return nil
+	}
+
+	sessionCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.watch(sessionCtx, topics, cancel)
+	}()
+	err = group.Consume(sessionCtx, topics, handler)
+	cancel()
+	<-done
+	return err
+}
+
+// watch periodically rediscovers the topics, and calls cancel when they differ from the given ones.
+func (m *topicMatcher) watch(ctx context.Context, topics []string, cancel context.CancelFunc) {
+	ticker := time.NewTicker(m.refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			current, err := m.matchingTopics()
+			if err != nil {
+				m.logger.Error("Failed to list the kafka topics", zap.Error(err))
+				continue
+			}
+			if !equalTopics(topics, current) {
+				m.logger.Info("The matching kafka topics changed, restarting the consumer session", zap.Strings("topics", current))
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+func (m *topicMatcher) close() error {
+	return m.client.Close()
+}
+
+func equalTopics(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// newConsumerGroup creates the consumer group for the given configuration, along with the
+// topic matcher when the topics are discovered with a regular expression.
+func newConsumerGroup(config Config, c *sarama.Config, logger *zap.Logger) (sarama.ConsumerGroup, *topicMatcher, error) {
+	if config.TopicRegex == "" {
+		group, err := sarama.NewConsumerGroup(config.Brokers, config.GroupID, c)
+		return group, nil, err
+	}
+
+	pattern, err := regexp.Compile(config.TopicRegex)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := sarama.NewClient(config.Brokers, c)
+	if err != nil {
+		return nil, nil, err
+	}
+	group, err := sarama.NewConsumerGroupFromClient(config.GroupID, client)
+	if err != nil {
+		_ = client.Close()
+		return nil, nil, err
+	}
+	return group, &topicMatcher{
+		client:          client,
+		pattern:         pattern,
+		refreshInterval: config.TopicRefreshInterval,
+		logger:          logger,
+	}, nil
+}
+
+// consumeTopics returns the static list of topics to consume from.
+func consumeTopics(config Config) []string {
+	if len(config.Topics) > 0 {
+		return config.Topics
+	}
+	return []string{config.Topic}
+}
@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkareceiver
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testTopicLister struct {
+	mu     sync.Mutex
+	topics []string
+	err    error
+}
+
+func (l *testTopicLister) RefreshMetadata(...string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.err
+}
+
+func (l *testTopicLister) Topics() ([]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.topics...), nil
+}
+
+func (l *testTopicLister) Close() error {
+	return nil
+}
+
+func (l *testTopicLister) setTopics(topics ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.topics = topics
+}
+
+// sessionConsumerGroup records the topics of each session, and keeps the sessions
+// running until their context is cancelled.
+type sessionConsumerGroup struct {
+	testConsumerGroup
+	mu       sync.Mutex
+	sessions [][]string
+}
+
+func (g *sessionConsumerGroup) Consume(ctx context.Context, topics []string, _ sarama.ConsumerGroupHandler) error {
+	g.mu.Lock()
+	g.sessions = append(g.sessions, topics)
+	g.mu.Unlock()
+	<-ctx.Done()
+	return nil
+}
+
+func (g *sessionConsumerGroup) sessionTopics() [][]string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([][]string(nil), g.sessions...)
+}
+
+func newTestTopicMatcher(lister topicLister) *topicMatcher {
+	return &topicMatcher{
+		client:          lister,
+		pattern:         regexp.MustCompile(`^otlp_`),
+		refreshInterval: 10 * time.Millisecond,
+		logger:          zap.NewNop(),
+	}
+}
+
+func TestTopicMatcherMatchingTopics(t *testing.T) {
+	lister := &testTopicLister{topics: []string{"otlp_spans_b", "logs", "otlp_spans_a", "__consumer_offsets"}}
+	topics, err := newTestTopicMatcher(lister).matchingTopics()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"otlp_spans_a", "otlp_spans_b"}, topics)
+
+	lister.err = errors.New("no broker available")
+	_, err = newTestTopicMatcher(lister).matchingTopics()
+	assert.EqualError(t, err, "no broker available")
+}
+
+func TestTopicMatcherRestartsSessionOnNewTopic(t *testing.T) {
+	lister := &testTopicLister{topics: []string{"otlp_spans"}}
+	matcher := newTestTopicMatcher(lister)
+	group := &sessionConsumerGroup{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for ctx.Err() == nil {
+			assert.NoError(t, matcher.consume(ctx, group, &tracesConsumerGroupHandler{}))
+		}
+	}()
+
+	assert.Eventually(t, func() bool {
+		return len(group.sessionTopics()) == 1
+	}, time.Second, time.Millisecond)
+	lister.setTopics("otlp_spans", "otlp_logs", "logs")
+	assert.Eventually(t, func() bool {
+		return len(group.sessionTopics()) == 2
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+	sessions := group.sessionTopics()
+	assert.Equal(t, []string{"otlp_spans"}, sessions[0])
+	assert.Equal(t, []string{"otlp_logs", "otlp_spans"}, sessions[1])
+}
+
+func TestTopicMatcherWaitsForMatchingTopics(t *testing.T) {
+	lister := &testTopicLister{topics: []string{"logs"}}
+	group := &sessionConsumerGroup{}
+
+	require.NoError(t, newTestTopicMatcher(lister).consume(context.Background(), group, &tracesConsumerGroupHandler{}))
+	assert.Empty(t, group.sessionTopics())
+}
+
+func TestConsumeTopics(t *testing.T) {
+	assert.Equal(t, []string{"spans"}, consumeTopics(Config{Topic: "spans"}))
+	assert.Equal(t, []string{"a", "b"}, consumeTopics(Config{Topic: "spans", Topics: []string{"a", "b"}}))
+}
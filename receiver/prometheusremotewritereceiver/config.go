@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewritereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusremotewritereceiver"
+
+import (
+	"errors"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+)
+
+var (
+	errMissingEndpoint = errors.New("missing receiver server endpoint from config")
+	errInvalidPath     = errors.New("path must start with '/'")
+)
+
+// Config defines configuration for the Prometheus remote write receiver.
+type Config struct {
+	confighttp.HTTPServerSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Path is the URL path the remote write requests are posted to.
+	Path string `mapstructure:"path"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks the receiver configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errMissingEndpoint
+	}
+	if !strings.HasPrefix(cfg.Path, "/") {
+		return errInvalidPath
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewritereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusremotewritereceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/prompb"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
+)
+
+const (
+	transport = "http"
+	format    = "prometheus_remote_write"
+	// scopeName is the instrumentation scope of the metrics created by the receiver.
+	scopeName = "otelcol/prometheusremotewritereceiver"
+	// defaultMaxRequestBodySize limits the compressed size of a request when max_request_body_size is not set.
+	defaultMaxRequestBodySize = 32 << 20
+	// maxDecodedRequestSize limits the size of a request once decompressed, as a small
+	// snappy payload can claim a very large decoded length.
+	maxDecodedRequestSize = 256 << 20
+)
+
+var (
+	errNilNextConsumer      = errors.New("nil next consumer")
+	errUnsupportedEncoding  = errors.New("only snappy encoded requests are supported")
+	errUnsupportedMediaType = errors.New("only protobuf requests are supported")
+	errRequestTooLarge      = errors.New("the request is too large")
+)
+
+type prometheusRemoteWriteReceiver struct {
+	settings     receiver.CreateSettings
+	cfg          *Config
+	nextConsumer consumer.Metrics
+	server       *http.Server
+	shutdownWG   sync.WaitGroup
+	obsrecv      *obsreport.Receiver
+}
+
+var _ receiver.Metrics = (*prometheusRemoteWriteReceiver)(nil)
+var _ http.Handler = (*prometheusRemoteWriteReceiver)(nil)
+
+func newMetricsReceiver(params receiver.CreateSettings, cfg *Config, nextConsumer consumer.Metrics) (*prometheusRemoteWriteReceiver, error) {
+	if nextConsumer == nil {
+		return nil, errNilNextConsumer
+	}
+
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             params.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &prometheusRemoteWriteReceiver{
+		settings:     params,
+		cfg:          cfg,
+		nextConsumer: nextConsumer,
+		obsrecv:      obsrecv,
+	}, nil
+}
+
+// Start starts the HTTP server receiving the remote write requests.
+func (r *prometheusRemoteWriteReceiver) Start(_ context.Context, host component.Host) error {
+	mux := http.NewServeMux()
+	mux.Handle(r.cfg.Path, r)
+
+	var err error
+	r.server, err = r.cfg.HTTPServerSettings.ToServer(host, r.settings.TelemetrySettings, mux)
+	if err != nil {
+		return err
+	}
+	listener, err := r.cfg.HTTPServerSettings.ToListener()
+	if err != nil {
+		return err
+	}
+
+	r.shutdownWG.Add(1)
+	go func() {
+		defer r.shutdownWG.Done()
+		if errHTTP := r.server.Serve(listener); errHTTP != nil && !errors.Is(errHTTP, http.ErrServerClosed) {
+			host.ReportFatalError(errHTTP)
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops the HTTP server.
+func (r *prometheusRemoteWriteReceiver) Shutdown(context.Context) error {
+	if r.server == nil {
+		return nil
+	}
+	err := r.server.Close()
+	r.shutdownWG.Wait()
+	return err
+}
+
+// ServeHTTP decodes a snappy compressed remote write request, and sends its
+// series to the next consumer as OTLP metrics.
+func (r *prometheusRemoteWriteReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	// the remote write protocol mandates snappy and protobuf, but older senders leave the headers out
+	if enc := req.Header.Get("Content-Encoding"); enc != "" && enc != "snappy" {
+		r.fail(w, http.StatusUnsupportedMediaType, errUnsupportedEncoding)
+		return
+	}
+	if ct := req.Header.Get("Content-Type"); ct != "" && ct != "application/x-protobuf" {
+		r.fail(w, http.StatusUnsupportedMediaType, errUnsupportedMediaType)
+		return
+	}
+
+	ctx := r.obsrecv.StartMetricsOp(req.Context())
+	maxBodySize := r.cfg.MaxRequestBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxRequestBodySize
+	}
+	writeReq, err := decodeWriteRequest(http.MaxBytesReader(w, req.Body, maxBodySize))
+	if err != nil {
+		r.obsrecv.EndMetricsOp(ctx, format, 0, err)
+		if errors.Is(err, errRequestTooLarge) {
+			r.fail(w, http.StatusRequestEntityTooLarge, err)
+		} else {
+			r.fail(w, http.StatusBadRequest, err)
+		}
+		return
+	}
+
+	md, translateErr := prometheusremotewrite.ToMetrics(writeReq)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		scope := md.ResourceMetrics().At(i).ScopeMetrics().At(0).Scope()
+		scope.SetName(scopeName)
+		scope.SetVersion(r.settings.BuildInfo.Version)
+	}
+
+	dataPointCount := md.DataPointCount()
+	if dataPointCount > 0 {
+		err = r.nextConsumer.ConsumeMetrics(ctx, md)
+	}
+	r.obsrecv.EndMetricsOp(ctx, format, dataPointCount, err)
+	switch {
+	case err != nil && consumererror.IsPermanent(err):
+		r.fail(w, http.StatusBadRequest, err)
+	case err != nil:
+		// the senders retry the requests failing with a server error
+		r.fail(w, http.StatusServiceUnavailable, err)
+	case translateErr != nil:
+		// the valid series were sent on, retrying wouldn't fix the others
+		r.fail(w, http.StatusBadRequest, translateErr)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func decodeWriteRequest(body io.Reader) (*prompb.WriteRequest, error) {
+	compressed, err := io.ReadAll(body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return nil, errRequestTooLarge
+		}
+		return nil, err
+	}
+	decodedLen, err := snappy.DecodedLen(compressed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress the request: %w", err)
+	}
+	if decodedLen > maxDecodedRequestSize {
+		return nil, errRequestTooLarge
+	}
+	payload, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress the request: %w", err)
+	}
+	var writeReq prompb.WriteRequest
+	if err = writeReq.Unmarshal(payload); err != nil {
+		return nil, fmt.Errorf("failed to decode the request: %w", err)
+	}
+	return &writeReq, nil
+}
+
+// fail logs the error and writes it as the response to the client.
+func (r *prometheusRemoteWriteReceiver) fail(w http.ResponseWriter, statusCode int, err error) {
+	r.settings.Logger.Debug("Failed to process remote write request", zap.Error(err), zap.Int("status_code", statusCode))
+	http.Error(w, err.Error(), statusCode)
+}
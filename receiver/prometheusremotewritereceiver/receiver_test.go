@@ -0,0 +1,220 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewritereceiver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func newTestReceiver(t *testing.T, nextConsumer *consumertest.MetricsSink) *prometheusRemoteWriteReceiver {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:0"
+	r, err := newMetricsReceiver(receivertest.NewNopCreateSettings(), cfg, nextConsumer)
+	require.NoError(t, err)
+	return r
+}
+
+func writeRequestBody(t *testing.T, req *prompb.WriteRequest) []byte {
+	payload, err := req.Marshal()
+	require.NoError(t, err)
+	return snappy.Encode(nil, payload)
+}
+
+func newRemoteWriteRequest(body []byte) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, defaultPath, bytes.NewReader(body))
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	return req
+}
+
+var testWriteRequest = &prompb.WriteRequest{
+	Timeseries: []prompb.TimeSeries{
+		{
+			Labels: []prompb.Label{
+				{Name: "__name__", Value: "http_requests_total"},
+				{Name: "job", Value: "api"},
+				{Name: "code", Value: "200"},
+			},
+			Samples: []prompb.Sample{{Value: 42, Timestamp: 1000}},
+		},
+	},
+}
+
+func TestStartShutdown(t *testing.T) {
+	r := newTestReceiver(t, new(consumertest.MetricsSink))
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestShutdownWithoutStart(t *testing.T) {
+	r := newTestReceiver(t, new(consumertest.MetricsSink))
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestServeHTTP(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	r := newTestReceiver(t, sink)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newRemoteWriteRequest(writeRequestBody(t, testWriteRequest)))
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	require.Len(t, sink.AllMetrics(), 1)
+	md := sink.AllMetrics()[0]
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	rm := md.ResourceMetrics().At(0)
+	assert.Equal(t, map[string]any{"service.name": "api"}, rm.Resource().Attributes().AsRaw())
+	assert.Equal(t, scopeName, rm.ScopeMetrics().At(0).Scope().Name())
+	metric := rm.ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "http_requests_total", metric.Name())
+	require.Equal(t, pmetric.MetricTypeSum, metric.Type())
+	assert.Equal(t, 42.0, metric.Sum().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, map[string]any{"code": "200"}, metric.Sum().DataPoints().At(0).Attributes().AsRaw())
+}
+
+func TestServeHTTPErrors(t *testing.T) {
+	tests := []struct {
+		name               string
+		request            func(t *testing.T) *http.Request
+		maxRequestBodySize int64
+		consumerErr        error
+		expectedCode       int
+	}{
+		{
+			name: "wrong method",
+			request: func(*testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodGet, defaultPath, nil)
+			},
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name: "wrong encoding",
+			request: func(t *testing.T) *http.Request {
+				req := newRemoteWriteRequest(writeRequestBody(t, testWriteRequest))
+				req.Header.Set("Content-Encoding", "gzip")
+				return req
+			},
+			expectedCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			name: "wrong content type",
+			request: func(t *testing.T) *http.Request {
+				req := newRemoteWriteRequest(writeRequestBody(t, testWriteRequest))
+				req.Header.Set("Content-Type", "application/json")
+				return req
+			},
+			expectedCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			name: "not snappy",
+			request: func(*testing.T) *http.Request {
+				return newRemoteWriteRequest([]byte("not snappy"))
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "not protobuf",
+			request: func(*testing.T) *http.Request {
+				return newRemoteWriteRequest(snappy.Encode(nil, []byte("not protobuf")))
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "body too large",
+			request: func(t *testing.T) *http.Request {
+				return newRemoteWriteRequest(writeRequestBody(t, testWriteRequest))
+			},
+			maxRequestBodySize: 10,
+			expectedCode:       http.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "decoded body too large",
+			request: func(*testing.T) *http.Request {
+				// a snappy block only made of the varint header claiming a 4 GiB decoded length
+				return newRemoteWriteRequest([]byte{0xff, 0xff, 0xff, 0xff, 0x0f})
+			},
+			expectedCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "invalid series",
+			request: func(t *testing.T) *http.Request {
+				return newRemoteWriteRequest(writeRequestBody(t, &prompb.WriteRequest{
+					Timeseries: []prompb.TimeSeries{{Samples: []prompb.Sample{{Value: 1}}}},
+				}))
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "consumer error",
+			request: func(t *testing.T) *http.Request {
+				return newRemoteWriteRequest(writeRequestBody(t, testWriteRequest))
+			},
+			consumerErr:  errors.New("temporary failure"),
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		{
+			name: "permanent consumer error",
+			request: func(t *testing.T) *http.Request {
+				return newRemoteWriteRequest(writeRequestBody(t, testWriteRequest))
+			},
+			consumerErr:  consumererror.NewPermanent(errors.New("bad data")),
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.MaxRequestBodySize = tt.maxRequestBodySize
+			var nextConsumer = consumertest.NewNop()
+			if tt.consumerErr != nil {
+				nextConsumer = consumertest.NewErr(tt.consumerErr)
+			}
+			r, err := newMetricsReceiver(receivertest.NewNopCreateSettings(), cfg, nextConsumer)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, tt.request(t))
+			assert.Equal(t, tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func TestEndToEnd(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:0"
+	r, err := newMetricsReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	// serve the receiver on a test server, to get hold of its address
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	req := newRemoteWriteRequest(writeRequestBody(t, testWriteRequest))
+	httpReq, err := http.NewRequest(http.MethodPost, server.URL+defaultPath, req.Body)
+	require.NoError(t, err)
+	httpReq.Header = req.Header
+	resp, err := server.Client().Do(httpReq)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, 1, sink.DataPointCount())
+}
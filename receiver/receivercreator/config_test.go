@@ -107,11 +107,13 @@ func TestLoadConfig(t *testing.T) {
 					component.NewIDWithName("mock_observer", "with_name"),
 				},
 				ResourceAttributes: map[observer.EndpointType]map[string]string{
-					observer.ContainerType: {"container.key": "container.value"},
-					observer.PodType:       {"pod.key": "pod.value"},
-					observer.PortType:      {"port.key": "port.value"},
-					observer.HostPortType:  {"hostport.key": "hostport.value"},
-					observer.K8sNodeType:   {"k8s.node.key": "k8s.node.value"},
+					observer.ContainerType:  {"container.key": "container.value"},
+					observer.PodType:        {"pod.key": "pod.value"},
+					observer.PortType:       {"port.key": "port.value"},
+					observer.HostPortType:   {"hostport.key": "hostport.value"},
+					observer.K8sNodeType:    {"k8s.node.key": "k8s.node.value"},
+					observer.K8sServiceType: {"k8s.service.key": "k8s.service.value"},
+					observer.K8sIngressType: {"k8s.ingress.key": "k8s.ingress.value"},
 				},
 			},
 		},
@@ -92,6 +92,46 @@ var k8sNodeEndpoint = observer.Endpoint{
 	},
 }
 
+var k8sServiceEndpoint = observer.Endpoint{
+	ID:     "k8s.service-1",
+	Target: "10.0.0.1",
+	Details: &observer.K8sService{
+		Annotations: map[string]string{
+			"prometheus.io/scrape": "true",
+		},
+		ClusterIP: "10.0.0.1",
+		Labels: map[string]string{
+			"app": "redis",
+		},
+		Name:        "a.name",
+		Namespace:   "default",
+		ServiceType: "ClusterIP",
+		Ports: []observer.K8sServicePort{
+			{Name: "redis", Port: 6379, Transport: observer.ProtocolTCP},
+		},
+		UID: "d4bd1a2e-8e66-4a4c-8cc4-a1e8a2de6f3a",
+	},
+}
+
+var k8sIngressEndpoint = observer.Endpoint{
+	ID:     "k8s.ingress-1",
+	Target: "https://example.com/api",
+	Details: &observer.K8sIngress{
+		Annotations: map[string]string{
+			"kubernetes.io/ingress.class": "nginx",
+		},
+		Host: "example.com",
+		Labels: map[string]string{
+			"app": "api",
+		},
+		Name:      "a.name",
+		Namespace: "default",
+		Path:      "/api",
+		Scheme:    "https",
+		UID:       "0b2d5c8a-5f17-4f8e-9e0a-6a3c2e9a6c1b",
+	},
+}
+
 var unsupportedEndpoint = observer.Endpoint{
 	ID:      "endpoint-1",
 	Target:  "localhost:1234",
@@ -4,10 +4,83 @@
 package webhookeventreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/webhookeventreceiver"
 
 import (
+	"errors"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.uber.org/multierr"
+)
+
+var (
+	errMissingEndpoint      = errors.New("missing receiver server endpoint from config")
+	errInvalidPath          = errors.New("path must start with '/'")
+	errInvalidHealthPath    = errors.New("health_path must start with '/'")
+	errSamePaths            = errors.New("path and health_path must be different")
+	errRequiredHeader       = errors.New("both key and value are needed when configuring required_header")
+	errNegativeReadTimeout  = errors.New("read_timeout must not be negative")
+	errNegativeWriteTimeout = errors.New("write_timeout must not be negative")
 )
 
 // Config defines configuration for the Generic Webhook receiver.
 type Config struct {
 	confighttp.HTTPServerSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Path is the URL path webhook payloads are posted to.
+	Path string `mapstructure:"path"`
+
+	// HealthPath is the URL path answering health checks with 200 OK.
+	HealthPath string `mapstructure:"health_path"`
+
+	// ReadTimeout is the maximum duration for reading the entire request.
+	ReadTimeout time.Duration `mapstructure:"read_timeout"`
+
+	// WriteTimeout is the maximum duration before timing out writes of the response.
+	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+
+	// RequiredHeader is a header that must be present with the given value
+	// for a payload to be accepted. Requests without it are rejected with 401.
+	RequiredHeader RequiredHeader `mapstructure:"required_header"`
+
+	// SplitLogsAtNewline creates a log record per non-empty line of the body
+	// instead of a single log record for the whole body.
+	SplitLogsAtNewline bool `mapstructure:"split_logs_at_newline"`
+}
+
+// RequiredHeader is a header key and value pair.
+type RequiredHeader struct {
+	Key   string `mapstructure:"key"`
+	Value string `mapstructure:"value"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+
+	if cfg.Endpoint == "" {
+		errs = multierr.Append(errs, errMissingEndpoint)
+	}
+	if !strings.HasPrefix(cfg.Path, "/") {
+		errs = multierr.Append(errs, errInvalidPath)
+	}
+	if !strings.HasPrefix(cfg.HealthPath, "/") {
+		errs = multierr.Append(errs, errInvalidHealthPath)
+	}
+	if cfg.Path == cfg.HealthPath {
+		errs = multierr.Append(errs, errSamePaths)
+	}
+	if (cfg.RequiredHeader.Key == "") != (cfg.RequiredHeader.Value == "") {
+		errs = multierr.Append(errs, errRequiredHeader)
+	}
+	if cfg.ReadTimeout < 0 {
+		errs = multierr.Append(errs, errNegativeReadTimeout)
+	}
+	if cfg.WriteTimeout < 0 {
+		errs = multierr.Append(errs, errNegativeWriteTimeout)
+	}
+
+	return errs
 }
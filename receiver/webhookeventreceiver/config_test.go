@@ -1,4 +1,100 @@
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
-package webhookeventreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/genericwebhookreceiver"
+package webhookeventreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/webhookeventreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr error
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom"),
+			expected: &Config{
+				HTTPServerSettings: confighttp.HTTPServerSettings{
+					Endpoint: "localhost:8088",
+				},
+				Path:         "/hooks/github",
+				HealthPath:   "/healthz",
+				ReadTimeout:  2 * time.Second,
+				WriteTimeout: 3 * time.Second,
+				RequiredHeader: RequiredHeader{
+					Key:   "X-Webhook-Token",
+					Value: "secret",
+				},
+				SplitLogsAtNewline: true,
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_header_value"),
+			expectedErr: errRequiredHeader,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_path"),
+			expectedErr: errInvalidPath,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "same_paths"),
+			expectedErr: errSamePaths,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	cfg := &Config{
+		ReadTimeout:  -time.Second,
+		WriteTimeout: -time.Second,
+	}
+	errs := multierr.Errors(cfg.Validate())
+	assert.ElementsMatch(t, []error{
+		errMissingEndpoint,
+		errInvalidPath,
+		errInvalidHealthPath,
+		errSamePaths,
+		errNegativeReadTimeout,
+		errNegativeWriteTimeout,
+	}, errs)
+}
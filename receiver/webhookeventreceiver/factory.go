@@ -5,6 +5,7 @@ package webhookeventreceiver // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -17,10 +18,16 @@ import (
 const (
 	// Default endpoints to bind to.
 	defaultEndpoint = ":8080"
+	// Default URL paths.
+	defaultPath       = "/events"
+	defaultHealthPath = "/health_check"
+	// Default server timeouts.
+	defaultReadTimeout  = 10 * time.Second
+	defaultWriteTimeout = 10 * time.Second
 )
 
 // NewFactory creates a factory for Generic Webhook Receiver.
-func NewFactory() component.Factory {
+func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
@@ -34,6 +41,10 @@ func createDefaultConfig() component.Config {
 		HTTPServerSettings: confighttp.HTTPServerSettings{
 			Endpoint: defaultEndpoint,
 		},
+		Path:         defaultPath,
+		HealthPath:   defaultHealthPath,
+		ReadTimeout:  defaultReadTimeout,
+		WriteTimeout: defaultWriteTimeout,
 	}
 }
 
@@ -2,3 +2,42 @@
 // SPDX-License-Identifier: Apache-2.0
 
 package webhookeventreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestValidConfig(t *testing.T) {
+	cfg := createDefaultConfig()
+	require.NoError(t, componenttest.CheckConfigStruct(cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestCreateLogsReceiver(t *testing.T) {
+	r, err := NewFactory().CreateLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		createDefaultConfig(),
+		consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+	assert.NotNil(t, r)
+}
+
+func TestCreateLogsReceiverWithoutConsumer(t *testing.T) {
+	_, err := NewFactory().CreateLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		createDefaultConfig(),
+		nil,
+	)
+	assert.ErrorIs(t, err, errNilLogsConsumer)
+}
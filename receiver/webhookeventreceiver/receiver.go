@@ -4,12 +4,217 @@
 package webhookeventreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/webhookeventreceiver"
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/obsreport"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
 )
 
+const (
+	transport = "http"
+	format    = "webhook"
+	// scopeName is the instrumentation scope of the log records created by
+	// the receiver.
+	scopeName = "otelcol/webhookeventreceiver"
+)
+
+var (
+	errNilLogsConsumer = errors.New("missing a logs consumer")
+	errMissingHost     = errors.New("nil host")
+	errEmptyBody       = errors.New("request body is empty")
+	errMissingHeader   = errors.New("required header missing or invalid")
+)
+
+// errorResponse is the body of the response to a request that failed.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+type eventReceiver struct {
+	settings    receiver.CreateSettings
+	cfg         *Config
+	logConsumer consumer.Logs
+	server      *http.Server
+	shutdownWG  sync.WaitGroup
+	obsrecv     *obsreport.Receiver
+	now         func() time.Time
+}
+
+var _ receiver.Logs = (*eventReceiver)(nil)
+var _ http.Handler = (*eventReceiver)(nil)
+
 func newLogsReceiver(params receiver.CreateSettings, cfg Config, consumer consumer.Logs) (receiver.Logs, error) {
-	return nil, errors.New("unimplemented")
+	if consumer == nil {
+		return nil, errNilLogsConsumer
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	obsrecv, err := obsreport.NewReceiver(obsreport.ReceiverSettings{
+		ReceiverID:             params.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventReceiver{
+		settings:    params,
+		cfg:         &cfg,
+		logConsumer: consumer,
+		obsrecv:     obsrecv,
+		now:         time.Now,
+	}, nil
+}
+
+// Start spins up the receiver's HTTP server and makes the receiver start
+// its processing.
+func (er *eventReceiver) Start(_ context.Context, host component.Host) error {
+	if host == nil {
+		return errMissingHost
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(er.cfg.Path, er)
+	mux.HandleFunc(er.cfg.HealthPath, er.handleHealthCheck)
+
+	var err error
+	er.server, err = er.cfg.HTTPServerSettings.ToServer(host, er.settings.TelemetrySettings, mux)
+	if err != nil {
+		return err
+	}
+	er.server.ReadTimeout = er.cfg.ReadTimeout
+	er.server.WriteTimeout = er.cfg.WriteTimeout
+
+	var listener net.Listener
+	listener, err = er.cfg.HTTPServerSettings.ToListener()
+	if err != nil {
+		return err
+	}
+
+	er.shutdownWG.Add(1)
+	go func() {
+		defer er.shutdownWG.Done()
+
+		if errHTTP := er.server.Serve(listener); errHTTP != nil && !errors.Is(errHTTP, http.ErrServerClosed) {
+			host.ReportFatalError(errHTTP)
+		}
+	}()
+
+	return nil
+}
+
+// Shutdown tells the receiver that should stop reception,
+// giving it a chance to perform any necessary clean-up and
+// shutting down its HTTP server.
+func (er *eventReceiver) Shutdown(context.Context) error {
+	if er.server == nil {
+		return nil
+	}
+	err := er.server.Close()
+	er.shutdownWG.Wait()
+	return err
+}
+
+// ServeHTTP turns the body of a webhook POST request into log records and
+// sends them to the next consumer.
+func (er *eventReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if er.cfg.RequiredHeader.Key != "" && r.Header.Get(er.cfg.RequiredHeader.Key) != er.cfg.RequiredHeader.Value {
+		er.failBadReq(w, http.StatusUnauthorized, errMissingHeader)
+		return
+	}
+
+	ctx := er.obsrecv.StartLogsOp(r.Context())
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		er.failBadReq(w, http.StatusBadRequest, err)
+		er.obsrecv.EndLogsOp(ctx, format, 0, err)
+		return
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		er.failBadReq(w, http.StatusBadRequest, errEmptyBody)
+		er.obsrecv.EndLogsOp(ctx, format, 0, errEmptyBody)
+		return
+	}
+
+	logs := er.bodyToLogs(body)
+	err = er.logConsumer.ConsumeLogs(ctx, logs)
+	er.obsrecv.EndLogsOp(ctx, format, logs.LogRecordCount(), err)
+	if err != nil {
+		er.failBadReq(w, http.StatusServiceUnavailable, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// handleHealthCheck answers health checks of load balancers and the like.
+func (er *eventReceiver) handleHealthCheck(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"text": "Webhookevent receiver is healthy"}`))
+}
+
+// bodyToLogs creates a log record for the body, or for every non-empty line
+// of the body if the receiver is configured to split it.
+func (er *eventReceiver) bodyToLogs(body []byte) plog.Logs {
+	logs := plog.NewLogs()
+	sl := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(scopeName)
+	sl.Scope().SetVersion(er.settings.BuildInfo.Version)
+
+	observed := pcommon.NewTimestampFromTime(er.now())
+	appendRecord := func(payload string) {
+		lr := sl.LogRecords().AppendEmpty()
+		lr.SetObservedTimestamp(observed)
+		lr.Body().SetStr(payload)
+	}
+
+	if !er.cfg.SplitLogsAtNewline {
+		appendRecord(string(body))
+		return logs
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), len(body)+1)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) > 0 {
+			appendRecord(scanner.Text())
+		}
+	}
+	return logs
+}
+
+// failBadReq logs the error and writes it as the response to the client.
+func (er *eventReceiver) failBadReq(w http.ResponseWriter, statusCode int, err error) {
+	er.settings.Logger.Debug("Failed to process webhook request", zap.Error(err), zap.Int("status_code", statusCode))
+	payload, _ := json.Marshal(errorResponse{Error: err.Error()})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if _, writeErr := w.Write(payload); writeErr != nil {
+		er.settings.Logger.Warn("Failed to write the response", zap.Error(writeErr))
+	}
 }
@@ -2,3 +2,165 @@
 // SPDX-License-Identifier: Apache-2.0
 
 package webhookeventreceiver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func testReceiver(t *testing.T, cfg *Config, next consumer.Logs) *eventReceiver {
+	r, err := newLogsReceiver(receivertest.NewNopCreateSettings(), *cfg, next)
+	require.NoError(t, err)
+	return r.(*eventReceiver)
+}
+
+func TestStartShutdown(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	sink := new(consumertest.LogsSink)
+	r := testReceiver(t, cfg, sink)
+
+	require.ErrorIs(t, r.Start(context.Background(), nil), errMissingHost)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, r.Shutdown(context.Background()))
+	})
+
+	resp, err := http.Post("http://"+cfg.Endpoint+cfg.Path, "application/json", strings.NewReader(`{"action":"opened"}`))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 1, sink.LogRecordCount())
+
+	resp, err = http.Get("http://" + cfg.Endpoint + cfg.HealthPath)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestShutdownWithoutStart(t *testing.T) {
+	r := testReceiver(t, createDefaultConfig().(*Config), consumertest.NewNop())
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestHandleReq(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		headers        map[string]string
+		configure      func(cfg *Config)
+		consumerErr    error
+		expectedStatus int
+		expectedBodies []string
+	}{
+		{
+			name:           "single record",
+			method:         http.MethodPost,
+			body:           "{\"a\":1}\n{\"a\":2}\n",
+			expectedStatus: http.StatusOK,
+			expectedBodies: []string{"{\"a\":1}\n{\"a\":2}\n"},
+		},
+		{
+			name:           "split at newline",
+			method:         http.MethodPost,
+			body:           "{\"a\":1}\n\n{\"a\":2}\r\n",
+			configure:      func(cfg *Config) { cfg.SplitLogsAtNewline = true },
+			expectedStatus: http.StatusOK,
+			expectedBodies: []string{`{"a":1}`, `{"a":2}`},
+		},
+		{
+			name:           "wrong method",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "empty body",
+			method:         http.MethodPost,
+			body:           "  \n",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "missing required header",
+			method: http.MethodPost,
+			body:   "event",
+			configure: func(cfg *Config) {
+				cfg.RequiredHeader = RequiredHeader{Key: "X-Token", Value: "secret"}
+			},
+			headers:        map[string]string{"X-Token": "wrong"},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "required header",
+			method: http.MethodPost,
+			body:   "event",
+			configure: func(cfg *Config) {
+				cfg.RequiredHeader = RequiredHeader{Key: "X-Token", Value: "secret"}
+			},
+			headers:        map[string]string{"X-Token": "secret"},
+			expectedStatus: http.StatusOK,
+			expectedBodies: []string{"event"},
+		},
+		{
+			name:           "consumer error",
+			method:         http.MethodPost,
+			body:           "event",
+			consumerErr:    errors.New("pipeline full"),
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			if tt.configure != nil {
+				tt.configure(cfg)
+			}
+			sink := new(consumertest.LogsSink)
+			var next consumer.Logs = sink
+			if tt.consumerErr != nil {
+				next = consumertest.NewErr(tt.consumerErr)
+			}
+			r := testReceiver(t, cfg, next)
+			now := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+			r.now = func() time.Time { return now }
+
+			req := httptest.NewRequest(tt.method, cfg.Path, strings.NewReader(tt.body))
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if len(tt.expectedBodies) == 0 {
+				assert.Equal(t, 0, sink.LogRecordCount())
+				return
+			}
+			require.Len(t, sink.AllLogs(), 1)
+			sl := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0)
+			assert.Equal(t, scopeName, sl.Scope().Name())
+			require.Equal(t, len(tt.expectedBodies), sl.LogRecords().Len())
+			for i, body := range tt.expectedBodies {
+				lr := sl.LogRecords().At(i)
+				assert.Equal(t, body, lr.Body().Str())
+				assert.Equal(t, pcommon.NewTimestampFromTime(now), lr.ObservedTimestamp())
+			}
+		})
+	}
+}